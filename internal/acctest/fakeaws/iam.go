@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type iamRole struct {
+	Path                     string
+	RoleName                 string
+	RoleID                   string `xml:"RoleId"`
+	Arn                      string
+	CreateDate               time.Time
+	AssumeRolePolicyDocument string
+	Description              string `xml:",omitempty"`
+	MaxSessionDuration       int
+	Tags                     []queryTag `xml:"Tags>member"`
+
+	tags map[string]string
+}
+
+// RegisterIAM registers a stateful fake of the AWS IAM role operations.
+func (s *Server) RegisterIAM() {
+	var lock sync.Mutex
+	roles := make(map[string]*iamRole) // Role name -> role.
+
+	findRole := func(name string) (*iamRole, error) {
+		role, ok := roles[name]
+		if !ok {
+			return nil, &Error{Code: "NoSuchEntity", Message: fmt.Sprintf("The role with name %s cannot be found.", name), StatusCode: http.StatusNotFound}
+		}
+		return role, nil
+	}
+	output := func(role *iamRole) *iamRole {
+		v := *role
+		v.Tags = queryTags(role.tags)
+		return &v
+	}
+
+	s.Handle("iam", "CreateRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		name := r.Form.Get("RoleName")
+		if _, ok := roles[name]; ok {
+			return nil, &Error{Code: "EntityAlreadyExists", Message: fmt.Sprintf("Role with name %s already exists.", name), StatusCode: http.StatusConflict}
+		}
+
+		path := r.Form.Get("Path")
+		if path == "" {
+			path = "/"
+		}
+		maxSessionDuration := 3600
+		if v, err := strconv.Atoi(r.Form.Get("MaxSessionDuration")); err == nil {
+			maxSessionDuration = v
+		}
+
+		role := &iamRole{
+			Path:                     path,
+			RoleName:                 name,
+			RoleID:                   fmt.Sprintf("AROA%017d", len(roles)+1),
+			Arn:                      GlobalARN("iam", "role"+path+name),
+			CreateDate:               time.Now().UTC().Truncate(time.Second),
+			AssumeRolePolicyDocument: url.QueryEscape(r.Form.Get("AssumeRolePolicyDocument")),
+			Description:              r.Form.Get("Description"),
+			MaxSessionDuration:       maxSessionDuration,
+			tags:                     r.FormTags("Tags.member"),
+		}
+		roles[name] = role
+
+		return struct {
+			XMLName xml.Name `xml:"CreateRoleResult"`
+			Role    *iamRole
+		}{Role: output(role)}, nil
+	})
+
+	s.Handle("iam", "GetRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			XMLName xml.Name `xml:"GetRoleResult"`
+			Role    *iamRole
+		}{Role: output(role)}, nil
+	})
+
+	s.Handle("iam", "UpdateRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := r.Form["Description"]; ok {
+			role.Description = r.Form.Get("Description")
+		}
+		if v, err := strconv.Atoi(r.Form.Get("MaxSessionDuration")); err == nil {
+			role.MaxSessionDuration = v
+		}
+
+		return nil, nil
+	})
+
+	s.Handle("iam", "UpdateRoleDescription", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+		role.Description = r.Form.Get("Description")
+
+		return struct {
+			XMLName xml.Name `xml:"UpdateRoleDescriptionResult"`
+			Role    *iamRole
+		}{Role: output(role)}, nil
+	})
+
+	s.Handle("iam", "UpdateAssumeRolePolicy", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+		role.AssumeRolePolicyDocument = url.QueryEscape(r.Form.Get("PolicyDocument"))
+
+		return nil, nil
+	})
+
+	s.Handle("iam", "DeleteRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		name := r.Form.Get("RoleName")
+		if _, err := findRole(name); err != nil {
+			return nil, err
+		}
+		delete(roles, name)
+
+		return nil, nil
+	})
+
+	s.Handle("iam", "ListRoleTags", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			XMLName     xml.Name   `xml:"ListRoleTagsResult"`
+			Tags        []queryTag `xml:"Tags>member"`
+			IsTruncated bool
+		}{Tags: queryTags(role.tags)}, nil
+	})
+
+	s.Handle("iam", "TagRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(role.tags, r.FormTags("Tags.member"))
+
+		return nil, nil
+	})
+
+	s.Handle("iam", "UntagRole", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		role, err := findRole(r.Form.Get("RoleName"))
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range r.FormStrings("TagKeys.member") {
+			delete(role.tags, k)
+		}
+
+		return nil, nil
+	})
+
+	// A role created by the fake never has policies or instance profiles.
+	for operation, result := range map[string]string{
+		"ListAttachedRolePolicies":    "AttachedPolicies",
+		"ListInstanceProfilesForRole": "InstanceProfiles",
+		"ListRolePolicies":            "PolicyNames",
+	} {
+		s.Handle("iam", operation, func(r *Request) (any, error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			if _, err := findRole(r.Form.Get("RoleName")); err != nil {
+				return nil, err
+			}
+
+			return emptyListResult{
+				XMLName: xml.Name{Local: operation + "Result"},
+				List:    emptyList{XMLName: xml.Name{Local: result}},
+			}, nil
+		})
+	}
+}
+
+type emptyListResult struct {
+	XMLName     xml.Name
+	List        emptyList
+	IsTruncated bool
+}
+
+type emptyList struct {
+	XMLName xml.Name
+}
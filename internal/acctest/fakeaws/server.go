@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements an in-process stand-in for AWS service endpoints.
+//
+// Tests register stateful handlers for Smithy operations and point the provider at the
+// server using the per-service `endpoints` provider configuration override.
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// AccountID is the account ID used in ARNs returned by the built-in fakes.
+	AccountID = "123456789012"
+	// Region is the Region used when a request's Region cannot be determined.
+	Region = "us-west-2" //lintignore:AWSAT003
+)
+
+// Request is a request for a single operation.
+type Request struct {
+	Service   string
+	Operation string
+	Region    string
+	// Params holds the decoded input of a JSON protocol (awsJson1_0, awsJson1_1) request.
+	Params map[string]any
+	// Form holds the input of a Query protocol (awsQuery, ec2Query) request.
+	Form url.Values
+	// HTTPRequest is the underlying HTTP request.
+	HTTPRequest *http.Request
+}
+
+// Handler handles a single operation.
+// For JSON protocol operations the returned value is marshaled as the JSON response body.
+// For Query protocol operations the returned value is marshaled as the XML result element, so it should
+// be a struct whose XMLName is the operation's result element, e.g. `xml:"CreateTopicResult"`, or nil.
+type Handler func(r *Request) (any, error)
+
+// Error is an AWS API error returned by a Handler.
+type Error struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// NewError returns a new client (HTTP 400) error.
+func NewError(code, format string, a ...any) *Error {
+	return &Error{
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// Server is an in-process fake AWS endpoint.
+// A single Server serves all registered services; the service is determined from the request signature.
+type Server struct {
+	*httptest.Server
+
+	handlers  map[string]map[string]Handler // Service signing name -> operation name -> handler.
+	lock      sync.RWMutex
+	requestID atomic.Int64
+}
+
+// NewServer starts a new Server. The Server is closed when the test completes.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+
+	s := &Server{
+		handlers: make(map[string]map[string]Handler),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Handle registers the handler for the specified service and operation, e.g. ("sqs", "CreateQueue").
+// The service is the service's signing name.
+func (s *Server) Handle(service, operation string, handler Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.handlers[service]; !ok {
+		s.handlers[service] = make(map[string]Handler)
+	}
+	s.handlers[service][operation] = handler
+}
+
+// Services returns the signing names of the services with registered handlers.
+func (s *Server) Services() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return slices.Sorted(maps.Keys(s.handlers))
+}
+
+// ProviderConfig returns provider configuration that sends all API calls for the registered services to the Server.
+// The service names used in the `endpoints` block are the provider's service package names, which are
+// the services' signing names for all built-in fakes.
+func (s *Server) ProviderConfig() string {
+	var endpoints strings.Builder
+	for _, service := range s.Services() {
+		fmt.Fprintf(&endpoints, "    %s = %q\n", service, s.URL)
+	}
+
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key                  = "mock_access_key"
+  secret_key                  = "mock_secret_key"
+  region                      = %[1]q
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+%[2]s  }
+}
+`, Region, endpoints.String())
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	service, region := credentialScope(r)
+	requestID := fmt.Sprintf("00000000-0000-0000-0000-%012d", s.requestID.Add(1))
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	request := &Request{
+		Service:     service,
+		Region:      region,
+		HTTPRequest: r,
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var query bool
+	switch mediaType {
+	case "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// X-Amz-Target: AmazonSQS.CreateQueue
+		_, request.Operation, _ = strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+		request.Params = make(map[string]any)
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &request.Params); err != nil {
+				writeJSONError(w, requestID, NewError("SerializationException", "%s", err))
+				return
+			}
+		}
+
+	case "application/x-www-form-urlencoded":
+		query = true
+		request.Form, err = url.ParseQuery(string(body))
+		if err != nil {
+			writeQueryError(w, requestID, NewError("MalformedQueryString", "%s", err))
+			return
+		}
+		request.Operation = request.Form.Get("Action")
+
+	default:
+		http.Error(w, fmt.Sprintf("unsupported Content-Type: %q", r.Header.Get("Content-Type")), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	s.lock.RLock()
+	handler, ok := s.handlers[service][request.Operation]
+	s.lock.RUnlock()
+
+	if !ok {
+		err := &Error{
+			Code:       "NotImplemented",
+			Message:    fmt.Sprintf("no handler registered for %s %s", service, request.Operation),
+			StatusCode: http.StatusNotImplemented,
+		}
+		if query {
+			writeQueryError(w, requestID, err)
+		} else {
+			writeJSONError(w, requestID, err)
+		}
+		return
+	}
+
+	output, err := handler(request)
+	if err != nil {
+		apiErr, ok := errs.As[*Error](err)
+		if !ok {
+			apiErr = &Error{Code: "InternalFailure", Message: err.Error(), StatusCode: http.StatusInternalServerError}
+		}
+		if query {
+			writeQueryError(w, requestID, apiErr)
+		} else {
+			writeJSONError(w, requestID, apiErr)
+		}
+		return
+	}
+
+	if query {
+		writeQueryResponse(w, requestID, request.Operation, output)
+	} else {
+		writeJSONResponse(w, requestID, mediaType, output)
+	}
+}
+
+// credentialScope returns the service signing name and Region from a SigV4 signed request.
+func credentialScope(r *http.Request) (string, string) {
+	// Authorization: AWS4-HMAC-SHA256 Credential=AKID/20260102/us-west-2/sqs/aws4_request, ...
+	if _, credential, ok := strings.Cut(r.Header.Get("Authorization"), "Credential="); ok {
+		credential, _, _ = strings.Cut(credential, ",")
+		if parts := strings.Split(credential, "/"); len(parts) == 5 {
+			return parts[3], parts[2]
+		}
+	}
+
+	return "", Region
+}
+
+func writeJSONResponse(w http.ResponseWriter, requestID, contentType string, output any) {
+	if output == nil {
+		output = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeJSONError(w http.ResponseWriter, requestID string, err *Error) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(err.StatusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"__type":  err.Code,
+		"message": err.Message,
+	})
+}
+
+type queryResponseMetadata struct {
+	RequestID string `xml:"RequestId"`
+}
+
+func writeQueryResponse(w http.ResponseWriter, requestID, operation string, output any) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(http.StatusOK)
+
+	encoder := xml.NewEncoder(w)
+	start := xml.StartElement{Name: xml.Name{Local: operation + "Response"}}
+	_ = encoder.EncodeToken(start)
+	if output != nil {
+		_ = encoder.Encode(output)
+	}
+	_ = encoder.EncodeElement(queryResponseMetadata{RequestID: requestID}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}})
+	_ = encoder.EncodeToken(start.End())
+	_ = encoder.Flush()
+}
+
+type queryErrorResponse struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Error     queryError
+	RequestID string `xml:"RequestId"`
+}
+
+type queryError struct {
+	Type    string
+	Code    string
+	Message string
+}
+
+func writeQueryError(w http.ResponseWriter, requestID string, err *Error) {
+	errorType := "Sender"
+	if err.StatusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(err.StatusCode)
+	_ = xml.NewEncoder(w).Encode(queryErrorResponse{
+		Error: queryError{
+			Type:    errorType,
+			Code:    err.Code,
+			Message: err.Message,
+		},
+		RequestID: requestID,
+	})
+}
+
+// ARN returns an ARN in the fake account.
+func ARN(service, region, resource string) string {
+	if region == "" {
+		region = Region
+	}
+	return fmt.Sprintf("arn:aws:%s:%s:%s:%s", service, region, AccountID, resource) //lintignore:AWSAT005
+}
+
+// GlobalARN returns an ARN for a global service, e.g. IAM, in the fake account.
+func GlobalARN(service, resource string) string {
+	return fmt.Sprintf("arn:aws:%s::%s:%s", service, AccountID, resource) //lintignore:AWSAT005
+}
+
+// String returns the string value of a JSON parameter.
+func (r *Request) String(name string) string {
+	v, _ := r.Params[name].(string)
+	return v
+}
+
+// Strings returns the value of a JSON list of strings parameter.
+func (r *Request) Strings(name string) []string {
+	var s []string
+	if v, ok := r.Params[name].([]any); ok {
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+	}
+	return s
+}
+
+// StringMap returns the value of a JSON map of strings parameter.
+func (r *Request) StringMap(name string) map[string]string {
+	m := make(map[string]string)
+	if v, ok := r.Params[name].(map[string]any); ok {
+		for k, v := range v {
+			if v, ok := v.(string); ok {
+				m[k] = v
+			}
+		}
+	}
+	return m
+}
+
+// FormMembers returns the members of a Query protocol list parameter, e.g. "Tags.member" or "Filter".
+// Each member's fields are keyed by the remainder of the parameter name, e.g. "Key", or "" for a list of scalars.
+func (r *Request) FormMembers(prefix string) []map[string]string {
+	var members []map[string]string
+	for i := 1; ; i++ {
+		memberPrefix := fmt.Sprintf("%s.%d.", prefix, i)
+		member := make(map[string]string)
+		for k, v := range r.Form {
+			if len(v) == 0 {
+				continue
+			}
+			if k+"." == memberPrefix {
+				member[""] = v[0]
+			} else if after, ok := strings.CutPrefix(k, memberPrefix); ok {
+				member[after] = v[0]
+			}
+		}
+		if len(member) == 0 {
+			return members
+		}
+		members = append(members, member)
+	}
+}
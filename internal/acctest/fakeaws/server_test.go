@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testConfig(s *fakeaws.Server) aws.Config {
+	return aws.Config{
+		BaseEndpoint: aws.String(s.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("mock_access_key", "mock_secret_key", ""),
+		Region:       fakeaws.Region,
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := fakeaws.NewServer(t)
+	s.RegisterSSM()
+	conn := ssm.NewFromConfig(testConfig(s))
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/test"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("v1"),
+		Tags:  []ssmtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}); err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/test"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("v2"),
+	}); !errs.IsA[*ssmtypes.ParameterAlreadyExists](err) {
+		t.Errorf("PutParameter without overwrite: got error %v, want ParameterAlreadyExists", err)
+	}
+
+	output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String("/test"),
+	})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+	if got, want := aws.ToString(output.Parameter.Value), "v1"; got != want {
+		t.Errorf("Value: got %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(output.Parameter.ARN), "arn:aws:ssm:us-west-2:123456789012:parameter/test"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("ARN: got %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String("/test"),
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+	})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+	if got, want := len(tags.TagList), 1; got != want {
+		t.Errorf("tags: got %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+		Name: aws.String("/test"),
+	}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	if _, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String("/test"),
+	}); !errs.IsA[*ssmtypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter after delete: got error %v, want ParameterNotFound", err)
+	}
+}
+
+func TestSSMParameterResource(t *testing.T) {
+	t.Parallel()
+
+	s := fakeaws.NewServer(t)
+	s.RegisterSSM()
+	conn := ssm.NewFromConfig(testConfig(s))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testCheckSSMParameterDestroy(t.Context(), conn),
+		Steps: []resource.TestStep{
+			{
+				Config: testSSMParameterResourceConfig(s, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckSSMParameterValue(t.Context(), conn, resourceName, "v1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, "arn:aws:ssm:us-west-2:123456789012:parameter/test"), //lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "v1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"has_value_wo"},
+			},
+			{
+				Config: testSSMParameterResourceConfig(s, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckSSMParameterValue(t.Context(), conn, resourceName, "v2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "v2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSSMParameterValue(ctx context.Context, conn *ssm.Client, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.Parameter.Value); got != want {
+			return fmt.Errorf("SSM Parameter (%s) value: got %q, want %q", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testCheckSSMParameterDestroy(ctx context.Context, conn *ssm.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter" {
+				continue
+			}
+
+			_, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+				Name: aws.String(rs.Primary.ID),
+			})
+
+			if errs.IsA[*ssmtypes.ParameterNotFound](err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Parameter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testSSMParameterResourceConfig(s *fakeaws.Server, value string) string {
+	return s.ProviderConfig() + fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = "/test"
+  type  = "String"
+  value = %[1]q
+
+  tags = {
+    Name = "test"
+  }
+}
+`, value)
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := fakeaws.NewServer(t)
+	s.RegisterSQS()
+	conn := sqs.NewFromConfig(testConfig(s))
+
+	output, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String("test"),
+		Attributes: map[string]string{"VisibilityTimeout": "60"},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       output.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["VisibilityTimeout"], "60"; got != want {
+		t.Errorf("VisibilityTimeout: got %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+		QueueUrl: output.QueueUrl,
+	}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	if _, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: output.QueueUrl,
+	}); !errs.IsA[*sqstypes.QueueDoesNotExist](err) {
+		t.Errorf("GetQueueAttributes after delete: got error %v, want QueueDoesNotExist", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := fakeaws.NewServer(t)
+	s.RegisterSNS()
+	conn := sns.NewFromConfig(testConfig(s))
+
+	output, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name:       aws.String("test"),
+		Attributes: map[string]string{"DisplayName": "Test"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+	if got, want := aws.ToString(output.TopicArn), "arn:aws:sns:us-west-2:123456789012:test"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("TopicArn: got %q, want %q", got, want)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: output.TopicArn,
+	})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName: got %q, want %q", got, want)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	s := fakeaws.NewServer(t)
+	s.RegisterIAM()
+	conn := iam.NewFromConfig(testConfig(s))
+
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String("test"),
+		AssumeRolePolicyDocument: aws.String(policy),
+		Tags:                     []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	output, err := conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(output.Role.Arn), "arn:aws:iam::123456789012:role/test"; got != want { //lintignore:AWSAT005
+		t.Errorf("Arn: got %q, want %q", got, want)
+	}
+	if got, want := len(output.Role.Tags), 1; got != want {
+		t.Errorf("Tags: got %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{
+		RoleName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	if _, err := conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String("test"),
+	}); !errs.IsA[*iamtypes.NoSuchEntityException](err) {
+		t.Errorf("GetRole after delete: got error %v, want NoSuchEntityException", err)
+	}
+}
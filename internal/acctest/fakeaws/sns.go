@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/xml"
+	"maps"
+	"slices"
+	"sync"
+)
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type queryTag struct {
+	Key   string
+	Value string
+}
+
+type queryAttribute struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func queryTags(tags map[string]string) []queryTag {
+	v := make([]queryTag, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		v = append(v, queryTag{Key: k, Value: tags[k]})
+	}
+	return v
+}
+
+// FormTags returns the tags in a Query protocol "Tags.member.N" list parameter.
+func (r *Request) FormTags(prefix string) map[string]string {
+	tags := make(map[string]string)
+	for _, member := range r.FormMembers(prefix) {
+		tags[member["Key"]] = member["Value"]
+	}
+	return tags
+}
+
+// FormStrings returns the values of a Query protocol list of strings parameter, e.g. "TagKeys.member".
+func (r *Request) FormStrings(prefix string) []string {
+	var v []string
+	for _, member := range r.FormMembers(prefix) {
+		v = append(v, member[""])
+	}
+	return v
+}
+
+// RegisterSNS registers a stateful fake of the Amazon SNS topic operations.
+func (s *Server) RegisterSNS() {
+	var lock sync.Mutex
+	topics := make(map[string]*snsTopic) // Topic ARN -> topic.
+
+	findTopic := func(arn string) (*snsTopic, error) {
+		topic, ok := topics[arn]
+		if !ok {
+			return nil, &Error{Code: "NotFound", Message: "Topic does not exist", StatusCode: 404}
+		}
+		return topic, nil
+	}
+
+	s.Handle("sns", "CreateTopic", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		arn := ARN("sns", r.Region, r.Form.Get("Name"))
+		if _, ok := topics[arn]; !ok {
+			attributes := map[string]string{
+				"DisplayName":             "",
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+				"Owner":                   AccountID,
+				"SubscriptionsConfirmed":  "0",
+				"SubscriptionsDeleted":    "0",
+				"SubscriptionsPending":    "0",
+				"TopicArn":                arn,
+			}
+			for _, member := range r.FormMembers("Attributes.entry") {
+				attributes[member["key"]] = member["value"]
+			}
+			topics[arn] = &snsTopic{
+				attributes: attributes,
+				tags:       r.FormTags("Tags.member"),
+			}
+		}
+
+		return struct {
+			XMLName  xml.Name `xml:"CreateTopicResult"`
+			TopicArn string
+		}{TopicArn: arn}, nil
+	})
+
+	s.Handle("sns", "GetTopicAttributes", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		topic, err := findTopic(r.Form.Get("TopicArn"))
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := make([]queryAttribute, 0, len(topic.attributes))
+		for _, k := range slices.Sorted(maps.Keys(topic.attributes)) {
+			attributes = append(attributes, queryAttribute{Key: k, Value: topic.attributes[k]})
+		}
+
+		return struct {
+			XMLName    xml.Name         `xml:"GetTopicAttributesResult"`
+			Attributes []queryAttribute `xml:"Attributes>entry"`
+		}{Attributes: attributes}, nil
+	})
+
+	s.Handle("sns", "SetTopicAttributes", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		topic, err := findTopic(r.Form.Get("TopicArn"))
+		if err != nil {
+			return nil, err
+		}
+		topic.attributes[r.Form.Get("AttributeName")] = r.Form.Get("AttributeValue")
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "DeleteTopic", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		delete(topics, r.Form.Get("TopicArn"))
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "ListTagsForResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		topic, err := findTopic(r.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, &Error{Code: "ResourceNotFound", Message: "Resource does not exist", StatusCode: 404}
+		}
+
+		return struct {
+			XMLName xml.Name   `xml:"ListTagsForResourceResult"`
+			Tags    []queryTag `xml:"Tags>member"`
+		}{Tags: queryTags(topic.tags)}, nil
+	})
+
+	s.Handle("sns", "TagResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		topic, err := findTopic(r.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, &Error{Code: "ResourceNotFound", Message: "Resource does not exist", StatusCode: 404}
+		}
+		maps.Copy(topic.tags, r.FormTags("Tags.member"))
+
+		return nil, nil
+	})
+
+	s.Handle("sns", "UntagResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		topic, err := findTopic(r.Form.Get("ResourceArn"))
+		if err != nil {
+			return nil, &Error{Code: "ResourceNotFound", Message: "Resource does not exist", StatusCode: 404}
+		}
+		for _, k := range r.FormStrings("TagKeys.member") {
+			delete(topic.tags, k)
+		}
+
+		return nil, nil
+	})
+}
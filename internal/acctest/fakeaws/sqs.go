@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type sqsQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// RegisterSQS registers a stateful fake of the Amazon SQS queue operations.
+func (s *Server) RegisterSQS() {
+	var lock sync.Mutex
+	queues := make(map[string]*sqsQueue) // Queue URL -> queue.
+
+	queueURL := func(name string) string {
+		return fmt.Sprintf("%s/%s/%s", s.URL, AccountID, name)
+	}
+	findQueue := func(r *Request) (*sqsQueue, error) {
+		queue, ok := queues[r.String("QueueUrl")]
+		if !ok {
+			return nil, NewError("QueueDoesNotExist", "The specified queue does not exist.")
+		}
+		return queue, nil
+	}
+
+	s.Handle("sqs", "CreateQueue", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		name := r.String("QueueName")
+		url := queueURL(name)
+		if _, ok := queues[url]; ok {
+			return map[string]any{"QueueUrl": url}, nil
+		}
+
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		attributes := map[string]string{
+			"CreatedTimestamp":              now,
+			"DelaySeconds":                  "0",
+			"LastModifiedTimestamp":         now,
+			"MaximumMessageSize":            "262144",
+			"MessageRetentionPeriod":        "345600",
+			"QueueArn":                      ARN("sqs", r.Region, name),
+			"ReceiveMessageWaitTimeSeconds": "0",
+			"SqsManagedSseEnabled":          "true",
+			"VisibilityTimeout":             "30",
+		}
+		maps.Copy(attributes, r.StringMap("Attributes"))
+		queues[url] = &sqsQueue{
+			attributes: attributes,
+			tags:       r.StringMap("tags"),
+		}
+
+		return map[string]any{"QueueUrl": url}, nil
+	})
+
+	s.Handle("sqs", "GetQueueUrl", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		url := queueURL(r.String("QueueName"))
+		if _, ok := queues[url]; !ok {
+			return nil, NewError("QueueDoesNotExist", "The specified queue does not exist.")
+		}
+
+		return map[string]any{"QueueUrl": url}, nil
+	})
+
+	s.Handle("sqs", "GetQueueAttributes", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queue, err := findQueue(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Attributes": queue.attributes}, nil
+	})
+
+	s.Handle("sqs", "SetQueueAttributes", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queue, err := findQueue(r)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(queue.attributes, r.StringMap("Attributes"))
+		queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "DeleteQueue", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if _, err := findQueue(r); err != nil {
+			return nil, err
+		}
+		delete(queues, r.String("QueueUrl"))
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "ListQueues", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		prefix := r.String("QueueNamePrefix")
+		urls := make([]string, 0)
+		for url := range queues {
+			if matched, _ := path.Match(prefix+"*", path.Base(url)); matched {
+				urls = append(urls, url)
+			}
+		}
+
+		return map[string]any{"QueueUrls": urls}, nil
+	})
+
+	s.Handle("sqs", "ListQueueTags", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queue, err := findQueue(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Tags": queue.tags}, nil
+	})
+
+	s.Handle("sqs", "TagQueue", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queue, err := findQueue(r)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(queue.tags, r.StringMap("Tags"))
+
+		return nil, nil
+	})
+
+	s.Handle("sqs", "UntagQueue", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queue, err := findQueue(r)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range r.Strings("TagKeys") {
+			delete(queue.tags, k)
+		}
+
+		return nil, nil
+	})
+}
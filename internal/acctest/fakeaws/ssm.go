@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type ssmParameter struct {
+	allowedPattern   string
+	dataType         string
+	description      string
+	lastModifiedDate time.Time
+	name             string
+	parameterType    string
+	tags             map[string]string
+	tier             string
+	value            string
+	version          int
+}
+
+func (p *ssmParameter) output(region string) map[string]any {
+	return map[string]any{
+		"ARN":              ARN("ssm", region, "parameter/"+strings.TrimPrefix(p.name, "/")),
+		"DataType":         p.dataType,
+		"LastModifiedDate": float64(p.lastModifiedDate.Unix()),
+		"Name":             p.name,
+		"Type":             p.parameterType,
+		"Value":            p.value,
+		"Version":          p.version,
+	}
+}
+
+func (p *ssmParameter) metadata(region string) map[string]any {
+	v := map[string]any{
+		"ARN":              ARN("ssm", region, "parameter/"+strings.TrimPrefix(p.name, "/")),
+		"DataType":         p.dataType,
+		"LastModifiedDate": float64(p.lastModifiedDate.Unix()),
+		"Name":             p.name,
+		"Tier":             p.tier,
+		"Type":             p.parameterType,
+		"Version":          p.version,
+	}
+	if p.allowedPattern != "" {
+		v["AllowedPattern"] = p.allowedPattern
+	}
+	if p.description != "" {
+		v["Description"] = p.description
+	}
+	return v
+}
+
+// RegisterSSM registers a stateful fake of the AWS Systems Manager Parameter Store operations.
+func (s *Server) RegisterSSM() {
+	var lock sync.Mutex
+	parameters := make(map[string]*ssmParameter) // Parameter name -> parameter.
+
+	findParameter := func(name string) (*ssmParameter, error) {
+		parameter, ok := parameters[name]
+		if !ok {
+			return nil, NewError("ParameterNotFound", "Parameter %s not found.", name)
+		}
+		return parameter, nil
+	}
+	tags := func(r *Request) map[string]string {
+		tags := make(map[string]string)
+		if v, ok := r.Params["Tags"].([]any); ok {
+			for _, v := range v {
+				if v, ok := v.(map[string]any); ok {
+					key, _ := v["Key"].(string)
+					value, _ := v["Value"].(string)
+					tags[key] = value
+				}
+			}
+		}
+		return tags
+	}
+
+	s.Handle("ssm", "PutParameter", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		name := r.String("Name")
+		parameter, ok := parameters[name]
+		if ok {
+			if overwrite, _ := r.Params["Overwrite"].(bool); !overwrite {
+				return nil, NewError("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+			}
+		} else {
+			parameter = &ssmParameter{
+				dataType: "text",
+				name:     name,
+				tags:     tags(r),
+				tier:     "Standard",
+			}
+			parameters[name] = parameter
+		}
+
+		parameter.lastModifiedDate = time.Now()
+		parameter.value = r.String("Value")
+		parameter.version++
+		if v := r.String("AllowedPattern"); v != "" || ok {
+			parameter.allowedPattern = v
+		}
+		if v := r.String("DataType"); v != "" {
+			parameter.dataType = v
+		}
+		if v := r.String("Description"); v != "" || ok {
+			parameter.description = v
+		}
+		if v := r.String("Type"); v != "" {
+			parameter.parameterType = v
+		}
+		if v := r.String("Tier"); v != "" && v != "Intelligent-Tiering" {
+			parameter.tier = v
+		}
+
+		return map[string]any{
+			"Tier":    parameter.tier,
+			"Version": parameter.version,
+		}, nil
+	})
+
+	s.Handle("ssm", "GetParameter", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		parameter, err := findParameter(r.String("Name"))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"Parameter": parameter.output(r.Region)}, nil
+	})
+
+	s.Handle("ssm", "GetParameters", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		found, invalid := make([]any, 0), make([]string, 0)
+		for _, name := range r.Strings("Names") {
+			if parameter, ok := parameters[name]; ok {
+				found = append(found, parameter.output(r.Region))
+			} else {
+				invalid = append(invalid, name)
+			}
+		}
+
+		return map[string]any{
+			"InvalidParameters": invalid,
+			"Parameters":        found,
+		}, nil
+	})
+
+	s.Handle("ssm", "DescribeParameters", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		// Only the "Name" filter with the "Equals" option is supported.
+		var names []string
+		if v, ok := r.Params["ParameterFilters"].([]any); ok {
+			for _, v := range v {
+				if v, ok := v.(map[string]any); ok && v["Key"] == "Name" {
+					for _, v := range v["Values"].([]any) {
+						names = append(names, v.(string))
+					}
+				}
+			}
+		}
+
+		output := make([]any, 0)
+		for _, name := range slices.Sorted(maps.Keys(parameters)) {
+			if names != nil && !slices.Contains(names, name) {
+				continue
+			}
+			output = append(output, parameters[name].metadata(r.Region))
+		}
+
+		return map[string]any{"Parameters": output}, nil
+	})
+
+	s.Handle("ssm", "DeleteParameter", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		name := r.String("Name")
+		if _, err := findParameter(name); err != nil {
+			return nil, err
+		}
+		delete(parameters, name)
+
+		return nil, nil
+	})
+
+	s.Handle("ssm", "ListTagsForResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		parameter, err := findParameter(r.String("ResourceId"))
+		if err != nil {
+			return nil, NewError("InvalidResourceId", "The resource ID is not valid.")
+		}
+
+		tagList := make([]any, 0)
+		for _, k := range slices.Sorted(maps.Keys(parameter.tags)) {
+			tagList = append(tagList, map[string]string{"Key": k, "Value": parameter.tags[k]})
+		}
+
+		return map[string]any{"TagList": tagList}, nil
+	})
+
+	s.Handle("ssm", "AddTagsToResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		parameter, err := findParameter(r.String("ResourceId"))
+		if err != nil {
+			return nil, NewError("InvalidResourceId", "The resource ID is not valid.")
+		}
+		maps.Copy(parameter.tags, tags(r))
+
+		return nil, nil
+	})
+
+	s.Handle("ssm", "RemoveTagsFromResource", func(r *Request) (any, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		parameter, err := findParameter(r.String("ResourceId"))
+		if err != nil {
+			return nil, NewError("InvalidResourceId", "The resource ID is not valid.")
+		}
+		for _, k := range r.Strings("TagKeys") {
+			delete(parameter.tags, k)
+		}
+
+		return nil, nil
+	})
+}
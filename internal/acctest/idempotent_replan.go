@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"os"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// IdempotentReplan returns a copy of the test case in which each step that applies a configuration also
+// asserts that the plans before and after the post-apply refresh are empty. When either isn't, the step fails
+// with a report of each attribute that would change and its before and after values. The pre-refresh check
+// runs before the testing framework's own non-empty plan check, so the report isn't preempted by its error.
+//
+// Steps that expect a non-empty plan or an error, and plan-only, destroy, import, refresh and query steps, are unchanged.
+//
+// Test and ParallelTest apply IdempotentReplan to every test case when the TF_ACC_IDEMPOTENT_REPLAN
+// environment variable is set.
+func IdempotentReplan(c resource.TestCase) resource.TestCase {
+	steps := make([]resource.TestStep, len(c.Steps))
+	for i, step := range c.Steps {
+		if appliesConfig(step) && !step.ExpectNonEmptyPlan && step.ExpectError == nil {
+			step.ConfigPlanChecks.PostApplyPreRefresh = append(slices.Clone(step.ConfigPlanChecks.PostApplyPreRefresh), tfplancheck.ExpectEmptyPlanWithDiff())
+			step.ConfigPlanChecks.PostApplyPostRefresh = append(slices.Clone(step.ConfigPlanChecks.PostApplyPostRefresh), tfplancheck.ExpectEmptyPlanWithDiff())
+		}
+		steps[i] = step
+	}
+	c.Steps = steps
+
+	return c
+}
+
+func appliesConfig(step resource.TestStep) bool {
+	if step.Config == "" && step.ConfigDirectory == nil && step.ConfigFile == nil {
+		return false
+	}
+
+	return !step.PlanOnly && !step.Destroy && !step.ImportState && !step.RefreshState && !step.Query
+}
+
+func idempotentReplanEnabled() bool {
+	return os.Getenv(envvar.AccIdempotentReplan) != ""
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIdempotentReplan(t *testing.T) {
+	t.Parallel()
+
+	c := acctest.IdempotentReplan(resource.TestCase{
+		Steps: []resource.TestStep{
+			{Config: "config"},
+			{Config: "config", ExpectNonEmptyPlan: true},
+			{Config: "config", ExpectError: regexp.MustCompile(`error`)},
+			{Config: "config", Destroy: true},
+			{Config: "config", ResourceName: "aws_test.test", ImportState: true},
+			{RefreshState: true},
+			{Config: "config", PlanOnly: true},
+		},
+	})
+
+	expected := []int{1, 0, 0, 0, 0, 0, 0}
+	for i, step := range c.Steps {
+		if got, want := len(step.ConfigPlanChecks.PostApplyPreRefresh), expected[i]; got != want {
+			t.Errorf("step %d: got %d post-apply pre-refresh plan checks, want %d", i+1, got, want)
+		}
+		if got, want := len(step.ConfigPlanChecks.PostApplyPostRefresh), expected[i]; got != want {
+			t.Errorf("step %d: got %d post-apply post-refresh plan checks, want %d", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+type expectEmptyPlanWithDiffCheck struct{}
+
+func (e expectEmptyPlanWithDiffCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	if request.Plan == nil {
+		response.Error = fmt.Errorf("plan is nil")
+
+		return
+	}
+
+	var report strings.Builder
+	for _, r := range request.Plan.ResourceChanges {
+		if r.Change == nil || r.Change.Actions.NoOp() || r.Change.Actions.Read() {
+			continue
+		}
+
+		fmt.Fprintf(&report, "\n%s planned action(s): %v", r.Address, r.Change.Actions)
+		for _, diff := range ResourceChangeDiffs(r.Change) {
+			fmt.Fprintf(&report, "\n  %s", diff)
+		}
+	}
+
+	if report.Len() > 0 {
+		response.Error = fmt.Errorf("expected empty plan after refresh, but found changes:%s", report.String())
+	}
+}
+
+// ExpectEmptyPlanWithDiff returns a plan check that asserts that a plan has no resource changes.
+// Unlike plancheck.ExpectEmptyPlan, the error reports each changed attribute with its before and after values.
+func ExpectEmptyPlanWithDiff() plancheck.PlanCheck {
+	return expectEmptyPlanWithDiffCheck{}
+}
+
+// ResourceChangeDiffs returns a description of each attribute changed by a planned resource change,
+// e.g. `tags.Name: "old" => "new"`, sorted by attribute path.
+func ResourceChangeDiffs(change *tfjson.Change) []string {
+	var diffs []string
+	diffValues("", change.Before, change.After, change.AfterUnknown, change.BeforeSensitive, change.AfterSensitive, &diffs)
+
+	return diffs
+}
+
+func diffValues(path string, before, after, afterUnknown, beforeSensitive, afterSensitive any, diffs *[]string) {
+	if v, ok := afterUnknown.(bool); ok && v {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s => (known after apply)", displayPath(path), displayValue(before, beforeSensitive)))
+
+		return
+	}
+
+	switch before := before.(type) {
+	case map[string]any:
+		if after, ok := after.(map[string]any); ok {
+			keys := append(slices.Collect(maps.Keys(before)), slices.Collect(maps.Keys(after))...)
+			slices.Sort(keys)
+
+			for _, k := range slices.Compact(keys) {
+				diffValues(joinPath(path, k), before[k], after[k], childValue(afterUnknown, k), childValue(beforeSensitive, k), childValue(afterSensitive, k), diffs)
+			}
+
+			return
+		}
+
+	case []any:
+		if after, ok := after.([]any); ok {
+			for i := range max(len(before), len(after)) {
+				var b, a any
+				if i < len(before) {
+					b = before[i]
+				}
+				if i < len(after) {
+					a = after[i]
+				}
+
+				diffValues(joinPath(path, strconv.Itoa(i)), b, a, childValue(afterUnknown, i), childValue(beforeSensitive, i), childValue(afterSensitive, i), diffs)
+			}
+
+			return
+		}
+	}
+
+	// Sensitive values are compared but never displayed.
+	if !jsonEqual(before, after) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s => %s", displayPath(path), displayValue(before, beforeSensitive), displayValue(after, afterSensitive)))
+	}
+}
+
+// childValue returns the element of an after_unknown or sensitive value for a map key or list index.
+// A true value applies to all of its elements.
+func childValue(v any, key any) any {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case map[string]any:
+		if k, ok := key.(string); ok {
+			return v[k]
+		}
+	case []any:
+		if i, ok := key.(int); ok && i < len(v) {
+			return v[i]
+		}
+	}
+
+	return nil
+}
+
+func isSensitive(v any) bool {
+	b, ok := v.(bool)
+	return ok && b
+}
+
+func jsonEqual(a, b any) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return string(x) == string(y)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(resource)"
+	}
+
+	return path
+}
+
+func displayValue(v, sensitive any) string {
+	if v == nil {
+		return "(null)"
+	}
+
+	if isSensitive(sensitive) {
+		return "(sensitive value)"
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
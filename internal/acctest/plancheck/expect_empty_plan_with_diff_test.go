@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+)
+
+func TestResourceChangeDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		change   tfjson.Change
+		expected []string
+	}{
+		"no change": {
+			change: tfjson.Change{
+				Before: map[string]any{"name": "test"},
+				After:  map[string]any{"name": "test"},
+			},
+		},
+		"attribute changed": {
+			change: tfjson.Change{
+				Before: map[string]any{"name": "test", "policy": `{"b":2,"a":1}`},
+				After:  map[string]any{"name": "test", "policy": `{"a":1,"b":2}`},
+			},
+			expected: []string{
+				`policy: "{\"b\":2,\"a\":1}" => "{\"a\":1,\"b\":2}"`,
+			},
+		},
+		"map element added and removed": {
+			change: tfjson.Change{
+				Before: map[string]any{"tags": map[string]any{"key1": "value1"}},
+				After:  map[string]any{"tags": map[string]any{"key2": "value2"}},
+			},
+			expected: []string{
+				`tags.key1: "value1" => (null)`,
+				`tags.key2: (null) => "value2"`,
+			},
+		},
+		"nested block defaulted": {
+			change: tfjson.Change{
+				Before: map[string]any{"configuration": []any{}},
+				After:  map[string]any{"configuration": []any{map[string]any{"enabled": false}}},
+			},
+			expected: []string{
+				`configuration.0: (null) => {"enabled":false}`,
+			},
+		},
+		"set element changed": {
+			change: tfjson.Change{
+				Before: map[string]any{"ingress": []any{map[string]any{"from_port": float64(80)}}},
+				After:  map[string]any{"ingress": []any{map[string]any{"from_port": float64(443)}}},
+			},
+			expected: []string{
+				`ingress.0.from_port: 80 => 443`,
+			},
+		},
+		"unknown after apply": {
+			change: tfjson.Change{
+				Before:       map[string]any{"arn": "arn:aws:s3:::test"}, //lintignore:AWSAT005
+				After:        map[string]any{},
+				AfterUnknown: map[string]any{"arn": true},
+			},
+			expected: []string{
+				`arn: "arn:aws:s3:::test" => (known after apply)`, //lintignore:AWSAT005
+			},
+		},
+		"sensitive": {
+			change: tfjson.Change{
+				Before:          map[string]any{"password": "old"},
+				After:           map[string]any{"password": "new"},
+				BeforeSensitive: map[string]any{"password": true},
+				AfterSensitive:  map[string]any{"password": true},
+			},
+			expected: []string{
+				`password: (sensitive value) => (sensitive value)`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfplancheck.ResourceChangeDiffs(&testCase.change)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpectEmptyPlanWithDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	var response plancheck.CheckPlanResponse
+	tfplancheck.ExpectEmptyPlanWithDiff().CheckPlan(ctx, plancheck.CheckPlanRequest{
+		Plan: &tfjson.Plan{
+			ResourceChanges: []*tfjson.ResourceChange{
+				{
+					Address: "aws_s3_bucket.test",
+					Change: &tfjson.Change{
+						Actions: tfjson.Actions{tfjson.ActionNoop},
+					},
+				},
+				{
+					Address: "aws_iam_policy.test",
+					Change: &tfjson.Change{
+						Actions: tfjson.Actions{tfjson.ActionUpdate},
+						Before:  map[string]any{"policy": "a"},
+						After:   map[string]any{"policy": "b"},
+					},
+				},
+			},
+		},
+	}, &response)
+
+	if response.Error == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expected := `expected empty plan after refresh, but found changes:
+aws_iam_policy.test planned action(s): [update]
+  policy: "a" => "b"`
+	if got := response.Error.Error(); got != expected {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+
+	response = plancheck.CheckPlanResponse{}
+	tfplancheck.ExpectEmptyPlanWithDiff().CheckPlan(ctx, plancheck.CheckPlanRequest{
+		Plan: &tfjson.Plan{},
+	}, &response)
+
+	if response.Error != nil {
+		t.Errorf("unexpected error: %s", response.Error)
+	}
+}
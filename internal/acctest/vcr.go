@@ -7,14 +7,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -28,7 +26,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
@@ -153,13 +150,24 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}
 
+		// Account IDs, partitions and Regions are replaced by placeholders in recorded interactions,
+		// and the placeholders by the replaying account's values in replayed responses.
+		// In RECORD_ONLY mode the account ID is added once the provider has been configured.
+		redactor := vcr.NewRedactor()
+		redactor.AddAccountAndRegions("", Partition(), Region(), AlternateRegion(), ThirdRegion())
+		if vcrMode == recorder.ModeReplayOnly {
+			redactor.AddReplacement(vcr.ReplayAccountID(), vcr.PlaceholderAccountID)
+		}
+
 		// Define how VCR will match requests to stored interactions.
+		// Requests are matched either as sent or as they would have been recorded, so that
+		// cassettes recorded before normalization was introduced still replay.
 		matchFunc := func(r *http.Request, i cassette.Request) bool {
 			if r.Method != i.Method {
 				return false
 			}
 
-			if r.URL.String() != i.URL {
+			if url := r.URL.String(); url != i.URL && redactor.NormalizeURL(url) != i.URL {
 				return false
 			}
 
@@ -177,39 +185,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 			r.Body = io.NopCloser(&b)
 			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				return tfjson.EqualStrings(body, i.Body)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
+			return vcr.RequestBodiesMatch(ctx, r, body, i.Body) || vcr.RequestBodiesMatch(ctx, r, redactor.NormalizeBody(r.Header.Get("Content-Type"), body), i.Body)
 		}
 
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
@@ -217,6 +194,8 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.New(cassetteName,
 			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+			recorder.WithHook(redactor.BeforeSaveHook, recorder.BeforeSaveHook),
+			recorder.WithHook(redactor.BeforeResponseReplayHook, recorder.BeforeResponseReplayHook),
 			recorder.WithMatcher(matchFunc),
 			recorder.WithMode(vcrMode),
 			recorder.WithRealTransport(httpClient.Transport),
@@ -245,6 +224,10 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
+		if vcrMode == recorder.ModeRecordOnly {
+			redactor.AddReplacement(meta.AccountID(ctx), vcr.PlaceholderAccountID)
+		}
+
 		providerMetas[testName] = meta
 
 		return meta, diags
@@ -392,7 +375,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
+// ParallelTest wraps resource.ParallelTest, initializing VCR and idempotent re-plan checks if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
@@ -405,10 +388,14 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 		}
 	}
 
+	if idempotentReplanEnabled() {
+		c = IdempotentReplan(c)
+	}
+
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled
+// Test wraps resource.Test, initializing VCR and idempotent re-plan checks if enabled
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
@@ -421,6 +408,10 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 		}
 	}
 
+	if idempotentReplanEnabled() {
+		c = IdempotentReplan(c)
+	}
+
 	resource.Test(t, c)
 }
 
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// APICallTraceFileEnvVar is the environment variable used to configure the API call trace file.
+	APICallTraceFileEnvVar = "TF_AWS_API_CALL_TRACE_FILE"
+)
+
+// apiCallRecord is a single line in the API call trace file.
+type apiCallRecord struct {
+	Time              time.Time `json:"time"`
+	Service           string    `json:"service"`
+	Operation         string    `json:"operation"`
+	Region            string    `json:"region,omitempty"`
+	ResourceType      string    `json:"resource_type,omitempty"`
+	ResourceID        string    `json:"resource_id,omitempty"`
+	ResourceOperation string    `json:"resource_operation,omitempty"`
+	LatencyMS         int64     `json:"latency_ms"`
+	Attempts          int       `json:"attempts"`
+	RetryCount        int       `json:"retry_count"`
+	ThrottlingErrors  []string  `json:"throttling_errors,omitempty"`
+	RequestID         string    `json:"request_id,omitempty"`
+	HTTPStatusCode    int       `json:"http_status_code,omitempty"`
+	Error             string    `json:"error,omitempty"`
+}
+
+// apiCallTracer writes a JSON Lines record for each AWS API call.
+type apiCallTracer struct {
+	encoder *json.Encoder
+	file    *os.File
+	lock    sync.Mutex
+}
+
+func newAPICallTracer(w io.Writer) *apiCallTracer {
+	return &apiCallTracer{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+var (
+	// apiCallTraceFiles are the trace files opened by all provider instances.
+	apiCallTraceFiles     []*apiCallTracer
+	apiCallTraceFilesLock sync.Mutex
+)
+
+// openAPICallTracer returns an API call tracer appending to the specified file.
+// The file is closed by CloseAPICallTraceFiles.
+func openAPICallTracer(path string) (*apiCallTracer, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := newAPICallTracer(f)
+	tracer.file = f
+
+	apiCallTraceFilesLock.Lock()
+	defer apiCallTraceFilesLock.Unlock()
+
+	apiCallTraceFiles = append(apiCallTraceFiles, tracer)
+
+	return tracer, nil
+}
+
+// CloseAPICallTraceFiles flushes and closes all open API call trace files.
+// It is called when Terraform stops the provider. Any subsequent API calls are not traced.
+func CloseAPICallTraceFiles() error {
+	apiCallTraceFilesLock.Lock()
+	defer apiCallTraceFilesLock.Unlock()
+
+	var errs []error
+	for _, v := range apiCallTraceFiles {
+		errs = append(errs, v.close())
+	}
+	apiCallTraceFiles = nil
+
+	return errors.Join(errs...)
+}
+
+func (t *apiCallTracer) close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.encoder = nil
+
+	if t.file == nil {
+		return nil
+	}
+
+	f := t.file
+	t.file = nil
+
+	return errors.Join(f.Sync(), f.Close())
+}
+
+func (t *apiCallTracer) write(record *apiCallRecord) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.encoder == nil {
+		return
+	}
+
+	_ = t.encoder.Encode(record) // Tracing must never cause an API call to fail.
+}
+
+// addMiddleware adds the tracer to the Finalize step, before the retry middleware, so that the recorded latency includes all retry attempts.
+// Service metadata, e.g. the operation name, is only available to middleware after the Initialize step.
+func (t *apiCallTracer) addMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("APICallTracer", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		start := time.Now()
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		t.write(newAPICallRecord(ctx, start, time.Since(start), metadata, err))
+
+		return out, metadata, err
+	}), "Retry", middleware.Before)
+}
+
+func newAPICallRecord(ctx context.Context, start time.Time, latency time.Duration, metadata middleware.Metadata, err error) *apiCallRecord {
+	record := &apiCallRecord{
+		Time:      start.UTC(),
+		Service:   awsmiddleware.GetServiceID(ctx),
+		Operation: awsmiddleware.GetOperationName(ctx),
+		Region:    awsmiddleware.GetRegion(ctx),
+		LatencyMS: latency.Milliseconds(),
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		record.ResourceType = inContext.TypeName()
+	}
+	record.ResourceOperation = ResourceOperationFromContext(ctx)
+	record.ResourceID = ResourceIDFromContext(ctx)
+
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		record.Attempts = len(results.Results)
+		for _, v := range results.Results {
+			if v.Retried {
+				record.RetryCount++
+			}
+			if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(v.Err) == aws.TrueTernary {
+				if apiErr, ok := errs.As[smithy.APIError](v.Err); ok {
+					record.ThrottlingErrors = append(record.ThrottlingErrors, apiErr.ErrorCode())
+				}
+			}
+		}
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		record.RequestID = v
+	}
+
+	if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		record.HTTPStatusCode = v.StatusCode
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	return record
+}
+
+type resourceOperationContextKeyType int
+
+var resourceOperationContextKey resourceOperationContextKeyType
+
+// NewResourceOperationContext returns a Context recording the resource operation, e.g. "Create", being handled.
+func NewResourceOperationContext(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, resourceOperationContextKey, operation)
+}
+
+// ResourceOperationFromContext returns the resource operation recorded by NewResourceOperationContext.
+func ResourceOperationFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceOperationContextKey).(string)
+	return v
+}
+
+type resourceIDContextKeyType int
+
+var resourceIDContextKey resourceIDContextKeyType
+
+// NewResourceIDContext returns a Context recording the ID of the resource being handled.
+// Terraform does not send resource addresses to providers, so the ID is the closest link from an API call back to configuration.
+func NewResourceIDContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, resourceIDContextKey, id)
+}
+
+// ResourceIDFromContext returns the resource ID recorded by NewResourceIDContext.
+func ResourceIDFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceIDContextKey).(string)
+	return v
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAPICallTracer(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch requests.Add(1) {
+		case 1:
+			w.Header().Set("X-Amzn-Requestid", "throttled-1")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>throttled-1</RequestId></ErrorResponse>`))
+		case 2:
+			w.Header().Set("X-Amzn-Requestid", "abc-123")
+			_, _ = w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>abc-123</RequestId></ResponseMetadata></GetCallerIdentityResponse>`))
+		default:
+			w.Header().Set("X-Amzn-Requestid", "def-456")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>Access denied</Message></Error><RequestId>def-456</RequestId></ErrorResponse>`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+	tracer := newAPICallTracer(&buf)
+
+	cfg := testAWSConfig(3)
+	cfg.APIOptions = append(cfg.APIOptions, tracer.addMiddleware)
+	client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	ctx := NewResourceOperationContext(t.Context(), "Read")
+	ctx = NewResourceIDContext(ctx, "vpc-12345678")
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("GetCallerIdentity: expected error")
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("trace lines: got %d, want %d", got, want)
+	}
+
+	var got []map[string]any
+	for _, line := range lines {
+		var v map[string]any
+		if err := json.Unmarshal(line, &v); err != nil {
+			t.Fatalf("unmarshaling trace line %q: %s", line, err)
+		}
+
+		// Time and latency vary between runs.
+		for _, k := range []string{"time", "latency_ms"} {
+			if _, ok := v[k]; !ok {
+				t.Errorf("trace line %q: missing %q", line, k)
+			}
+			delete(v, k)
+		}
+
+		got = append(got, v)
+	}
+
+	if v, ok := got[1]["error"].(string); !ok || !strings.Contains(v, "AccessDenied") {
+		t.Errorf("error: got %v, want AccessDenied", got[1]["error"])
+	}
+	delete(got[1], "error")
+
+	want := []map[string]any{
+		{
+			"service":            "STS",
+			"operation":          "GetCallerIdentity",
+			"region":             "us-west-2", //lintignore:AWSAT003
+			"resource_operation": "Read",
+			"resource_id":        "vpc-12345678",
+			"attempts":           float64(2),
+			"retry_count":        float64(1),
+			"throttling_errors":  []any{"Throttling"},
+			"request_id":         "abc-123",
+			"http_status_code":   float64(http.StatusOK),
+		},
+		{
+			"service":            "STS",
+			"operation":          "GetCallerIdentity",
+			"region":             "us-west-2", //lintignore:AWSAT003
+			"resource_operation": "Read",
+			"resource_id":        "vpc-12345678",
+			"attempts":           float64(1),
+			"retry_count":        float64(0),
+			"request_id":         "def-456",
+			"http_status_code":   float64(http.StatusForbidden),
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected trace (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCloseAPICallTraceFiles(t *testing.T) { //nolint:paralleltest // Closes all open trace files
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := openAPICallTracer(path)
+	if err != nil {
+		t.Fatalf("opening trace file: %s", err)
+	}
+
+	start := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	tracer.write(newAPICallRecord(ctx, start, 0, middleware.Metadata{}, nil))
+
+	if err := CloseAPICallTraceFiles(); err != nil {
+		t.Fatalf("closing trace files: %s", err)
+	}
+
+	// Writes after close are dropped.
+	tracer.write(newAPICallRecord(ctx, start, 0, middleware.Metadata{}, nil))
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading trace file: %s", err)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(b), []byte("\n"))
+	if got, want := len(lines), 1; got != want {
+		t.Fatalf("trace lines: got %d, want %d", got, want)
+	}
+
+	var got apiCallRecord
+	if err := json.Unmarshal(lines[0], &got); err != nil {
+		t.Fatalf("unmarshaling trace line %q: %s", lines[0], err)
+	}
+	if got, want := got.Time, start; !got.Equal(want) {
+		t.Errorf("time: got %s, want %s", got, want)
+	}
+}
+
+func TestResourceIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if got, want := ResourceIDFromContext(ctx), ""; got != want {
+		t.Errorf("ResourceIDFromContext: got %q, want %q", got, want)
+	}
+
+	ctx = NewResourceIDContext(ctx, "")
+
+	if got, want := ResourceIDFromContext(ctx), ""; got != want {
+		t.Errorf("ResourceIDFromContext: got %q, want %q", got, want)
+	}
+
+	ctx = NewResourceIDContext(ctx, "i-1234567890abcdef0")
+
+	if got, want := ResourceIDFromContext(ctx), "i-1234567890abcdef0"; got != want {
+		t.Errorf("ResourceIDFromContext: got %q, want %q", got, want)
+	}
+}
+
+func TestResourceOperationFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if got, want := ResourceOperationFromContext(ctx), ""; got != want {
+		t.Errorf("ResourceOperationFromContext: got %q, want %q", got, want)
+	}
+
+	ctx = NewResourceOperationContext(ctx, "Create")
+
+	if got, want := ResourceOperationFromContext(ctx), "Create"; got != want {
+		t.Errorf("ResourceOperationFromContext: got %q, want %q", got, want)
+	}
+}
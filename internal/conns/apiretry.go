@@ -4,8 +4,14 @@
 package conns
 
 import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
 )
 
 // AddIsErrorRetryables returns a Retryer which runs the specified retryables on any error.
@@ -27,3 +33,118 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// ServiceRetryConfig overrides the provider-level retry behavior for a single service's API client.
+// Zero values leave the corresponding provider-level setting in effect.
+type ServiceRetryConfig struct {
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RequestsPerSecond   float64
+	RetryableErrorCodes []string
+}
+
+// AddServiceRetryConfig returns a Retryer with the specified per-service overrides applied.
+func AddServiceRetryConfig(r aws.RetryerV2, config ServiceRetryConfig) aws.RetryerV2 {
+	if v := config.MaxAttempts; v > 0 {
+		r = retry.AddWithMaxAttempts(r, v).(aws.RetryerV2)
+	}
+	if v := config.MaxBackoff; v > 0 {
+		r = &withBackoff{
+			RetryerV2: r,
+			backoff:   &v1CompatibleBackoff{maxRetryDelay: v},
+		}
+	}
+	if v := config.RetryableErrorCodes; len(v) > 0 {
+		r = retry.AddWithErrorCodes(r, v...).(aws.RetryerV2)
+	}
+
+	return r
+}
+
+type withBackoff struct {
+	aws.RetryerV2
+	backoff retry.BackoffDelayer
+}
+
+func (r *withBackoff) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
+
+// serviceAWSConfig returns a copy of the specified AWS SDK for Go v2 configuration with per-service retry overrides applied.
+// The returned configuration's rate limiter is shared by all API clients built from it.
+func serviceAWSConfig(cfg *aws.Config, config ServiceRetryConfig) *aws.Config {
+	v := cfg.Copy()
+
+	retryer := cfg.Retryer
+	v.Retryer = func() aws.Retryer {
+		if retryer == nil {
+			return AddServiceRetryConfig(retry.NewStandard(), config)
+		}
+		return AddServiceRetryConfig(retryer().(aws.RetryerV2), config)
+	}
+	// API clients wrap their Retryer with RetryMaxAttempts when it is non-zero, which would override the per-service value.
+	// The provider-level Retryer already applies the provider-level maximum.
+	v.RetryMaxAttempts = config.MaxAttempts
+
+	if rps := config.RequestsPerSecond; rps > 0 {
+		limiter := newRequestRateLimiter(rps)
+		v.APIOptions = append(slices.Clone(cfg.APIOptions), limiter.addMiddleware)
+	}
+
+	return &v
+}
+
+// requestRateLimiter spaces API requests evenly so that no more than the configured number are sent per second.
+type requestRateLimiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     time.Time
+}
+
+func newRequestRateLimiter(requestsPerSecond float64) *requestRateLimiter {
+	return &requestRateLimiter{
+		interval: time.Duration(float64(time.Second) / requestsPerSecond),
+	}
+}
+
+// reserve returns the time at which the next request may be sent.
+func (l *requestRateLimiter) reserve(now time.Time) time.Time {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	t := l.next
+	if t.Before(now) {
+		t = now
+	}
+	l.next = t.Add(l.interval)
+
+	return t
+}
+
+func (l *requestRateLimiter) wait(ctx context.Context) error {
+	d := time.Until(l.reserve(time.Now()))
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// addMiddleware adds the rate limiter to the end of the Finalize step so that each retry attempt is also rate limited.
+func (l *requestRateLimiter) addMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("ServiceRequestRateLimiter", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After)
+}
@@ -6,12 +6,18 @@ package conns
 import (
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	appconfigtypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	smithy "github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -93,3 +99,163 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddServiceRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	throttlingErr := &smithy.GenericAPIError{Code: "Throttling"}
+	customErr := &smithy.GenericAPIError{Code: "ConcurrentModificationException"}
+
+	testCases := []struct {
+		name                string
+		config              ServiceRetryConfig
+		expectedMaxAttempts int
+		expectedRetryable   bool
+		maxDelay            time.Duration
+	}{
+		{
+			name:                "empty",
+			expectedMaxAttempts: retry.DefaultMaxAttempts,
+		},
+		{
+			name: "max attempts",
+			config: ServiceRetryConfig{
+				MaxAttempts: 40,
+			},
+			expectedMaxAttempts: 40,
+		},
+		{
+			name: "retryable error codes",
+			config: ServiceRetryConfig{
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			expectedMaxAttempts: retry.DefaultMaxAttempts,
+			expectedRetryable:   true,
+		},
+		{
+			name: "max backoff",
+			config: ServiceRetryConfig{
+				MaxBackoff: 2 * time.Second,
+			},
+			expectedMaxAttempts: retry.DefaultMaxAttempts,
+			maxDelay:            2 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := AddServiceRetryConfig(retry.NewStandard(), testCase.config)
+
+			if got, want := r.MaxAttempts(), testCase.expectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+			if got, want := r.IsErrorRetryable(customErr), testCase.expectedRetryable; got != want {
+				t.Errorf("IsErrorRetryable(%q) = %v, want %v", customErr, got, want)
+			}
+			if !r.IsErrorRetryable(throttlingErr) {
+				t.Errorf("IsErrorRetryable(%q) = false, want true", throttlingErr)
+			}
+			if testCase.maxDelay > 0 {
+				for attempt := 1; attempt <= 20; attempt++ {
+					delay, err := r.RetryDelay(attempt, throttlingErr)
+					if err != nil {
+						t.Fatalf("RetryDelay(%d): %s", attempt, err)
+					}
+					if delay > testCase.maxDelay {
+						t.Errorf("RetryDelay(%d) = %s, want at most %s", attempt, delay, testCase.maxDelay)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestServiceAWSConfigMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	const providerMaxAttempts = 25
+
+	testCases := []struct {
+		name             string
+		config           ServiceRetryConfig
+		expectedAttempts int32
+	}{
+		{
+			name:             "provider-level",
+			expectedAttempts: providerMaxAttempts,
+		},
+		{
+			name: "per-service",
+			config: ServiceRetryConfig{
+				MaxAttempts: 3,
+			},
+			expectedAttempts: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			t.Cleanup(server.Close)
+
+			cfg := testAWSConfig(providerMaxAttempts)
+			client := sts.NewFromConfig(*serviceAWSConfig(&cfg, testCase.config), func(o *sts.Options) {
+				o.BaseEndpoint = aws.String(server.URL)
+			})
+
+			if _, err := client.GetCallerIdentity(t.Context(), &sts.GetCallerIdentityInput{}); err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := attempts.Load(), testCase.expectedAttempts; got != want {
+				t.Errorf("attempts = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRequestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l := newRequestRateLimiter(4)
+	now := time.Now()
+
+	for i, want := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := l.reserve(now).Sub(now); got != want {
+			t.Errorf("reservation %d: got %s, want %s", i, got, want)
+		}
+	}
+
+	// An idle limiter does not accumulate credit.
+	later := now.Add(10 * time.Second)
+	if got := l.reserve(later); !got.Equal(later) {
+		t.Errorf("reservation after idle: got %s, want %s", got.Sub(now), later.Sub(now))
+	}
+}
+
+// testAWSConfig returns an AWS SDK for Go v2 configuration mirroring the provider-level configuration built by aws-sdk-go-base.
+// Retries are not delayed.
+func testAWSConfig(maxAttempts int) aws.Config {
+	return aws.Config{
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: maxAttempts,
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = maxAttempts
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+				o.RateLimiter = ratelimit.None
+			})
+		},
+	}
+}
@@ -38,6 +38,8 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	readCache                 *readCache             // Nil unless enabled in provider configuration.
+	serviceAWSConfigs         map[string]*aws.Config // Service package name -> AWS SDK for Go v2 configuration with retry overrides.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -338,8 +340,12 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if v, ok := c.serviceAWSConfigs[servicePackageName]; ok {
+		awsConfig = v
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
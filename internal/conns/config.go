@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -29,7 +30,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallTraceFile               string
+	APIOptions                     []func(*middleware.Stack) error // Additional middleware for all AWS API clients.
 	AssumeRole                     []awsbase.AssumeRole
+	AssumeRoleWithCertificate      *AssumeRoleWithCertificate
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -45,11 +49,13 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadCache                      bool
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRetry                   map[string]ServiceRetryConfig // Service package name -> retry overrides.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -58,6 +64,7 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
+	TagPolicyFile                  string
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -139,6 +146,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// IAM Roles Anywhere credentials are retrieved here and passed to aws-sdk-go-base as static credentials
+	// so that any assume_role chain is validated. The credentials are then replaced by a refreshing provider.
+	var rolesAnywhereCredentials aws.CredentialsProvider
+	if c.AssumeRoleWithCertificate != nil {
+		provider, err := newRolesAnywhereCredentialsProvider(*c.AssumeRoleWithCertificate, c.Endpoints[names.RolesAnywhere], client.HTTPClient(ctx))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring IAM Roles Anywhere credentials: %s", err)
+		}
+
+		rolesAnywhereCredentials = aws.NewCredentialsCache(provider)
+		credentials, err := rolesAnywhereCredentials.Retrieve(ctx)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving IAM Roles Anywhere credentials: %s", err)
+		}
+
+		awsbaseConfig.AccessKey = credentials.AccessKeyID
+		awsbaseConfig.SecretKey = credentials.SecretAccessKey
+		awsbaseConfig.Token = credentials.SessionToken
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -159,6 +186,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if rolesAnywhereCredentials != nil {
+		if len(c.AssumeRole) == 0 {
+			cfg.Credentials = rolesAnywhereCredentials
+		} else {
+			cfg.Credentials = assumeRoleChainCredentialsProvider(cfg, rolesAnywhereCredentials, c.AssumeRole, c.STSRegion, c.Endpoints[names.STS])
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -196,7 +231,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	if c.TagPolicyConfig != nil && c.TagPolicyFile != "" {
+		tflog.Debug(ctx, "Reading tag policy details from file", map[string]any{
+			"path": c.TagPolicyFile,
+		})
+		reqTags, tagRules, err := tagpolicy.ReadPolicyFile(ctx, c.TagPolicyFile)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy File",
+				fmt.Sprintf("Failed to read the local tag policy document.\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.TagRules = tagRules
+	} else if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -208,6 +256,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tagRules, err := tagpolicy.GetTagRules(ctx, cfg)
+		if err != nil {
+			// Tag key and value rules are supplemental to required tags, so failing
+			// to retrieve them should not prevent the provider from configuring.
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Tag Policy Rules",
+				`Failed to retrieve the effective tag policy from AWS Organizations. Tag key and value rules will not be enforced. `+
+					`Ensure the calling principal has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		}
+		c.TagPolicyConfig.TagRules = tagRules
 	}
 
 	client.accountID = accountID
@@ -216,6 +276,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
+	if c.APICallTraceFile != "" {
+		tracer, err := openAPICallTracer(c.APICallTraceFile)
+		if err != nil {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Opening API Call Trace File",
+				fmt.Sprintf("AWS API calls will not be traced.\n\nOriginal error: %s", err)))
+		} else {
+			cfg.APIOptions = append(cfg.APIOptions, tracer.addMiddleware)
+		}
+	}
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
@@ -223,6 +295,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	if c.ReadCache {
+		client.readCache = newReadCache()
+	}
+	client.serviceAWSConfigs = make(map[string]*aws.Config, len(c.ServiceRetry))
+	for servicePackageName, config := range c.ServiceRetry {
+		client.serviceAWSConfigs[servicePackageName] = serviceAWSConfig(&cfg, config)
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache holds the results of batch API reads, keyed by resource family, for the lifetime of a provider instance.
+// Terraform configures a new provider instance for each operation, so cached results are never reused across runs.
+type readCache struct {
+	families map[string]*readCacheFamily
+	lock     sync.Mutex
+}
+
+type readCacheFamily struct {
+	items map[string]any
+	once  sync.Once
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		families: make(map[string]*readCacheFamily),
+	}
+}
+
+func (c *readCache) family(name string) *readCacheFamily {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	v, ok := c.families[name]
+	if !ok {
+		v = &readCacheFamily{}
+		c.families[name] = v
+	}
+
+	return v
+}
+
+type readCacheContextKeyType int
+
+var readCacheContextKey readCacheContextKeyType
+
+// ReadCacheContext returns a Context in which finders may be served from the provider's read cache.
+// It must only be used for resource refreshes: any Read following a Create or Update must not use it.
+// If the read cache is not enabled the Context is returned unchanged.
+func (c *AWSClient) ReadCacheContext(ctx context.Context) context.Context {
+	if c.readCache == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, readCacheContextKey, c.readCache)
+}
+
+// CachedRead returns the value for key in the specified resource family.
+// On first use in a family, prefetch is called to read all of the family's values, typically with a single paginated API call.
+// The returned bool is false if the Context does not permit cached reads, the prefetch failed or key is not in the family.
+// In that case the caller should fall back to its usual API call.
+func CachedRead[T any](ctx context.Context, family, key string, prefetch func(context.Context) (map[string]T, error)) (T, bool) {
+	var zero T
+
+	cache, ok := ctx.Value(readCacheContextKey).(*readCache)
+	if !ok {
+		return zero, false
+	}
+
+	f := cache.family(family)
+	f.once.Do(func() {
+		items, err := prefetch(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "prefetching resource family for read cache", map[string]any{
+				"tf_aws.read_cache.family": family,
+				"error":                    err.Error(),
+			})
+			return
+		}
+
+		tflog.Debug(ctx, "prefetched resource family for read cache", map[string]any{
+			"tf_aws.read_cache.family": family,
+			"tf_aws.read_cache.count":  len(items),
+		})
+		f.items = make(map[string]any, len(items))
+		for k, v := range items {
+			f.items[k] = v
+		}
+	})
+
+	// Items are only written inside once.Do, so concurrent reads are safe.
+	v, ok := f.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	return v.(T), true
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCachedRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	var calls int
+	prefetch := func(context.Context) (map[string]int, error) {
+		calls++
+		return map[string]int{"one": 1, "two": 2}, nil
+	}
+
+	// Cached reads not enabled.
+	if _, ok := CachedRead(ctx, "family", "one", prefetch); ok {
+		t.Errorf("CachedRead without read cache: got found, want not found")
+	}
+	if calls != 0 {
+		t.Errorf("prefetch calls without read cache: got %d, want 0", calls)
+	}
+
+	c := &AWSClient{readCache: newReadCache()}
+	ctx = c.ReadCacheContext(ctx)
+
+	for _, testCase := range []struct {
+		key           string
+		expectedValue int
+		expectedFound bool
+	}{
+		{key: "one", expectedValue: 1, expectedFound: true},
+		{key: "two", expectedValue: 2, expectedFound: true},
+		{key: "three"},
+		{key: "one", expectedValue: 1, expectedFound: true},
+	} {
+		v, ok := CachedRead(ctx, "family", testCase.key, prefetch)
+		if got, want := ok, testCase.expectedFound; got != want {
+			t.Errorf("CachedRead(%q) found: got %t, want %t", testCase.key, got, want)
+		}
+		if got, want := v, testCase.expectedValue; got != want {
+			t.Errorf("CachedRead(%q): got %d, want %d", testCase.key, got, want)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("prefetch calls: got %d, want 1", calls)
+	}
+
+	// A failed prefetch is not retried and falls back to the caller.
+	var errorCalls int
+	prefetchError := func(context.Context) (map[string]int, error) {
+		errorCalls++
+		return nil, errors.New("AccessDenied")
+	}
+	for range 2 {
+		if _, ok := CachedRead(ctx, "failing", "one", prefetchError); ok {
+			t.Errorf("CachedRead after failed prefetch: got found, want not found")
+		}
+	}
+	if errorCalls != 1 {
+		t.Errorf("failed prefetch calls: got %d, want 1", errorCalls)
+	}
+}
+
+func TestReadCacheContextDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	c := &AWSClient{}
+
+	if got := c.ReadCacheContext(ctx); got != ctx {
+		t.Errorf("ReadCacheContext with read cache disabled returned a new Context")
+	}
+}
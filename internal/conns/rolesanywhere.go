@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// AssumeRoleWithCertificate configures credentials obtained from IAM Roles Anywhere using an X.509 certificate.
+type AssumeRoleWithCertificate struct {
+	CertificateChainFile string
+	CertificateFile      string
+	Duration             time.Duration
+	PKCS11URI            string
+	PrivateKeyFile       string
+	ProfileARN           string
+	RoleARN              string
+	TrustAnchorARN       string
+}
+
+const (
+	rolesAnywhereSigningAlgorithmECDSA = "AWS4-X509-ECDSA-SHA256"
+	rolesAnywhereSigningAlgorithmRSA   = "AWS4-X509-RSA-SHA256"
+	rolesAnywhereSigningName           = "rolesanywhere"
+)
+
+// rolesAnywhereCredentialsProvider retrieves temporary credentials by calling the IAM Roles Anywhere CreateSession API.
+// Requests are signed with the certificate's private key as described in
+// https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-sign-process.html.
+type rolesAnywhereCredentialsProvider struct {
+	certificate *x509.Certificate
+	chain       []*x509.Certificate
+	config      AssumeRoleWithCertificate
+	endpoint    string
+	httpClient  aws.HTTPClient
+	region      string
+	signer      crypto.Signer
+	now         func() time.Time
+}
+
+func newRolesAnywhereCredentialsProvider(config AssumeRoleWithCertificate, endpoint string, httpClient aws.HTTPClient) (*rolesAnywhereCredentialsProvider, error) {
+	region, err := rolesAnywhereRegion(config.TrustAnchorARN)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := readCertificates(config.CertificateFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificate: %w", err)
+	}
+	if len(certificates) != 1 {
+		return nil, fmt.Errorf("reading certificate: expected 1 certificate in %s, found %d", config.CertificateFile, len(certificates))
+	}
+
+	var chain []*x509.Certificate
+	if config.CertificateChainFile != "" {
+		chain, err = readCertificates(config.CertificateChainFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate chain: %w", err)
+		}
+	}
+
+	var signer crypto.Signer
+	if config.PKCS11URI != "" {
+		uri, err := parsePKCS11URI(config.PKCS11URI)
+		if err != nil {
+			return nil, err
+		}
+
+		signer, err = newPKCS11Signer(uri, certificates[0].PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("opening PKCS#11 private key: %w", err)
+		}
+	} else {
+		signer, err = readPrivateKey(config.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading private key: %w", err)
+		}
+	}
+
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://rolesanywhere.%s.amazonaws.com", region)
+	}
+
+	return &rolesAnywhereCredentialsProvider{
+		certificate: certificates[0],
+		chain:       chain,
+		config:      config,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		httpClient:  httpClient,
+		region:      region,
+		signer:      signer,
+		now:         time.Now,
+	}, nil
+}
+
+// rolesAnywhereRegion returns the Region of the specified trust anchor.
+// CreateSession must be called in the trust anchor's Region, which may differ from the provider's.
+func rolesAnywhereRegion(trustAnchorARN string) (string, error) {
+	v, err := arn.Parse(trustAnchorARN)
+	if err != nil {
+		return "", fmt.Errorf("parsing trust anchor ARN (%s): %w", trustAnchorARN, err)
+	}
+
+	if v.Region == "" {
+		return "", fmt.Errorf("trust anchor ARN (%s) does not contain a Region", trustAnchorARN)
+	}
+
+	return v.Region, nil
+}
+
+type rolesAnywhereCreateSessionInput struct {
+	DurationSeconds int    `json:"durationSeconds,omitempty"`
+	ProfileARN      string `json:"profileArn"`
+	RoleARN         string `json:"roleArn"`
+	TrustAnchorARN  string `json:"trustAnchorArn"`
+}
+
+type rolesAnywhereCreateSessionOutput struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			Expiration      string `json:"expiration"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+}
+
+// Retrieve implements aws.CredentialsProvider.
+func (p *rolesAnywhereCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	body, err := json.Marshal(rolesAnywhereCreateSessionInput{
+		DurationSeconds: int(p.config.Duration.Seconds()),
+		ProfileARN:      p.config.ProfileARN,
+		RoleARN:         p.config.RoleARN,
+		TrustAnchorARN:  p.config.TrustAnchorARN,
+	})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/sessions", bytes.NewReader(body))
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	if err := p.sign(request, body); err != nil {
+		return aws.Credentials{}, fmt.Errorf("signing IAM Roles Anywhere CreateSession request: %w", err)
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: %w", err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: %s: %s", response.Status, responseBody)
+	}
+
+	var output rolesAnywhereCreateSessionOutput
+	if err := json.Unmarshal(responseBody, &output); err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	if len(output.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New("IAM Roles Anywhere CreateSession returned no credentials")
+	}
+
+	v := output.CredentialSet[0].Credentials
+	expires, err := time.Parse(time.RFC3339, v.Expiration)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading IAM Roles Anywhere credentials expiration: %w", err)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     v.AccessKeyID,
+		SecretAccessKey: v.SecretAccessKey,
+		SessionToken:    v.SessionToken,
+		Source:          "IAMRolesAnywhereProvider",
+		CanExpire:       true,
+		Expires:         expires,
+	}, nil
+}
+
+func (p *rolesAnywhereCredentialsProvider) sign(request *http.Request, body []byte) error {
+	var algorithm string
+	switch p.signer.Public().(type) {
+	case *rsa.PublicKey:
+		algorithm = rolesAnywhereSigningAlgorithmRSA
+	case *ecdsa.PublicKey:
+		algorithm = rolesAnywhereSigningAlgorithmECDSA
+	default:
+		return fmt.Errorf("unsupported private key type %T", p.signer.Public())
+	}
+
+	now := p.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := strings.Join([]string{now.Format("20060102"), p.region, rolesAnywhereSigningName, "aws4_request"}, "/")
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-X509", base64.StdEncoding.EncodeToString(p.certificate.Raw))
+	signedHeaders := []string{"content-type", "host", "x-amz-date", "x-amz-x509"}
+	if len(p.chain) > 0 {
+		var chain []string
+		for _, v := range p.chain {
+			chain = append(chain, base64.StdEncoding.EncodeToString(v.Raw))
+		}
+		request.Header.Set("X-Amz-X509-Chain", strings.Join(chain, ","))
+		signedHeaders = append(signedHeaders, "x-amz-x509-chain")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, k := range signedHeaders {
+		v := request.Header.Get(k)
+		if k == "host" {
+			v = request.URL.Host
+		}
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", k, strings.TrimSpace(v))
+	}
+
+	bodyHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		algorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := p.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, p.certificate.SerialNumber.String(), scope, strings.Join(signedHeaders, ";"), hex.EncodeToString(signature)))
+
+	return nil
+}
+
+func readCertificates(path string) ([]*x509.Certificate, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no PEM-encoded certificates found in %s", path)
+	}
+
+	return certificates, nil
+}
+
+func readPrivateKey(path string) (crypto.Signer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+
+		var key any
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case *ecdsa.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+	}
+
+	return nil, fmt.Errorf("no unencrypted PEM-encoded private key found in %s", path)
+}
+
+// assumeRoleChainCredentialsProvider returns a credentials provider that assumes each IAM role in turn, starting from the source credentials.
+// It is used in place of the chain built by aws-sdk-go-base so that the source credentials can be refreshed.
+func assumeRoleChainCredentialsProvider(cfg aws.Config, source aws.CredentialsProvider, assumeRoles []awsbase.AssumeRole, stsRegion, stsEndpoint string) aws.CredentialsProvider {
+	credentials := source
+
+	for _, ar := range assumeRoles {
+		cfg.Credentials = credentials
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws.String(stsEndpoint)
+			}
+		})
+
+		credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = ar.SessionName
+			o.Duration = ar.Duration
+
+			if ar.ExternalID != "" {
+				o.ExternalID = aws.String(ar.ExternalID)
+			}
+
+			if ar.Policy != "" {
+				o.Policy = aws.String(ar.Policy)
+			}
+
+			for _, v := range ar.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws.String(v)})
+			}
+
+			for k, v := range ar.Tags {
+				o.Tags = append(o.Tags, ststypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+
+			o.TransitiveTagKeys = ar.TransitiveTagKeys
+
+			if ar.SourceIdentity != "" {
+				o.SourceIdentity = aws.String(ar.SourceIdentity)
+			}
+		}))
+	}
+
+	return credentials
+}
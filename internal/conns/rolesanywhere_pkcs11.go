@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const pkcs11URIScheme = "pkcs11:"
+
+// pkcs11URI identifies a private key on a PKCS#11 token, as described in https://www.rfc-editor.org/rfc/rfc7512.
+type pkcs11URI struct {
+	// Path attributes select the token and the key.
+	ID           []byte
+	Manufacturer string
+	Model        string
+	Object       string
+	Serial       string
+	SlotID       *uint
+	Token        string
+
+	// Query attributes locate the PKCS#11 module and supply the token PIN.
+	ModulePath string
+	PIN        string
+}
+
+// parsePKCS11URI parses a PKCS#11 URI.
+// The module-path query attribute is required. The PIN is taken from the pin-value or pin-source query attribute.
+// Errors don't include the URI, as it may contain the PIN.
+func parsePKCS11URI(s string) (*pkcs11URI, error) {
+	rest, ok := strings.CutPrefix(s, pkcs11URIScheme)
+	if !ok {
+		return nil, fmt.Errorf("PKCS#11 URI must start with %q", pkcs11URIScheme)
+	}
+
+	path, query, _ := strings.Cut(rest, "?")
+
+	uri := &pkcs11URI{}
+
+	for attr := range strings.SplitSeq(path, ";") {
+		if attr == "" {
+			continue
+		}
+
+		name, value, err := parsePKCS11URIAttribute(attr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing PKCS#11 URI: %w", err)
+		}
+
+		switch name {
+		case "id":
+			uri.ID = []byte(value)
+		case "manufacturer":
+			uri.Manufacturer = value
+		case "model":
+			uri.Model = value
+		case "object":
+			uri.Object = value
+		case "serial":
+			uri.Serial = value
+		case "slot-id":
+			v, err := strconv.ParseUint(value, 10, 0)
+			if err != nil {
+				return nil, fmt.Errorf("parsing PKCS#11 URI: invalid slot-id %q", value)
+			}
+			slotID := uint(v)
+			uri.SlotID = &slotID
+		case "token":
+			uri.Token = value
+		case "type":
+			if value != "private" {
+				return nil, fmt.Errorf("parsing PKCS#11 URI: object type must be \"private\", got %q", value)
+			}
+		}
+	}
+
+	for attr := range strings.SplitSeq(query, "&") {
+		if attr == "" {
+			continue
+		}
+
+		name, value, err := parsePKCS11URIAttribute(attr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing PKCS#11 URI: %w", err)
+		}
+
+		switch name {
+		case "module-path":
+			uri.ModulePath = value
+		case "pin-source":
+			b, err := os.ReadFile(strings.TrimPrefix(value, "file:"))
+			if err != nil {
+				return nil, fmt.Errorf("reading PKCS#11 PIN: %w", err)
+			}
+			uri.PIN = strings.TrimRight(string(b), "\r\n")
+		case "pin-value":
+			uri.PIN = value
+		}
+	}
+
+	if uri.ModulePath == "" {
+		return nil, errors.New("PKCS#11 URI must contain a module-path query attribute")
+	}
+
+	return uri, nil
+}
+
+func parsePKCS11URIAttribute(attr string) (string, string, error) {
+	name, value, ok := strings.Cut(attr, "=")
+	if !ok {
+		return "", "", fmt.Errorf("attribute %q has no value", name)
+	}
+
+	value, err := url.PathUnescape(value)
+	if err != nil {
+		return "", "", fmt.Errorf("attribute %q: %w", name, err)
+	}
+
+	return name, value, nil
+}
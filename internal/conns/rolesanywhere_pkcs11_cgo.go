@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build cgo
+
+package conns
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// sha256DigestInfoPrefix is the DER-encoded DigestInfo prefix for a SHA-256 digest, which CKM_RSA_PKCS doesn't add.
+var sha256DigestInfoPrefix = []byte{0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20}
+
+// pkcs11Signer signs with a private key held on a PKCS#11 token.
+// The session stays open so that credentials can be refreshed for the lifetime of the provider.
+type pkcs11Signer struct {
+	ctx       *pkcs11.Ctx
+	key       pkcs11.ObjectHandle
+	mutex     sync.Mutex
+	publicKey crypto.PublicKey
+	session   pkcs11.SessionHandle
+}
+
+// newPKCS11Signer returns a signer for the private key identified by the URI.
+// The public key is taken from the certificate, so the token only needs to hold the private key.
+func newPKCS11Signer(uri *pkcs11URI, publicKey crypto.PublicKey) (crypto.Signer, error) {
+	switch publicKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	ctx := pkcs11.New(uri.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("loading PKCS#11 module %s", uri.ModulePath)
+	}
+
+	if err := ctx.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		ctx.Destroy()
+		return nil, fmt.Errorf("initializing PKCS#11 module %s: %w", uri.ModulePath, err)
+	}
+
+	signer, err := openPKCS11Signer(ctx, uri, publicKey)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+func openPKCS11Signer(ctx *pkcs11.Ctx, uri *pkcs11URI, publicKey crypto.PublicKey) (*pkcs11Signer, error) {
+	slot, err := findPKCS11Slot(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("opening PKCS#11 session: %w", err)
+	}
+
+	if uri.PIN != "" {
+		if err := ctx.Login(session, pkcs11.CKU_USER, uri.PIN); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+			ctx.CloseSession(session)
+			return nil, fmt.Errorf("logging in to PKCS#11 token: %w", err)
+		}
+	}
+
+	key, err := findPKCS11PrivateKey(ctx, session, uri)
+	if err != nil {
+		ctx.CloseSession(session)
+		return nil, err
+	}
+
+	return &pkcs11Signer{
+		ctx:       ctx,
+		key:       key,
+		publicKey: publicKey,
+		session:   session,
+	}, nil
+}
+
+func findPKCS11Slot(ctx *pkcs11.Ctx, uri *pkcs11URI) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("listing PKCS#11 slots: %w", err)
+	}
+
+	var matches []uint
+	for _, slot := range slots {
+		if uri.SlotID != nil && *uri.SlotID != slot {
+			continue
+		}
+
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("reading PKCS#11 token information: %w", err)
+		}
+
+		if !pkcs11AttributeMatches(uri.Token, info.Label) ||
+			!pkcs11AttributeMatches(uri.Manufacturer, info.ManufacturerID) ||
+			!pkcs11AttributeMatches(uri.Model, info.Model) ||
+			!pkcs11AttributeMatches(uri.Serial, info.SerialNumber) {
+			continue
+		}
+
+		matches = append(matches, slot)
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, errors.New("no PKCS#11 token matches the URI")
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, fmt.Errorf("%d PKCS#11 tokens match the URI", len(matches))
+	}
+}
+
+// pkcs11AttributeMatches reports whether a token information field matches a URI attribute.
+// Token information fields are padded with spaces.
+func pkcs11AttributeMatches(want, got string) bool {
+	return want == "" || want == strings.TrimRight(got, " \x00")
+}
+
+func findPKCS11PrivateKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, uri *pkcs11URI) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+	}
+	if uri.Object != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, uri.Object))
+	}
+	if len(uri.ID) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, uri.ID))
+	}
+
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return 0, fmt.Errorf("finding PKCS#11 private key: %w", err)
+	}
+
+	objects, _, err := ctx.FindObjects(session, 2)
+	if err := errors.Join(err, ctx.FindObjectsFinal(session)); err != nil {
+		return 0, fmt.Errorf("finding PKCS#11 private key: %w", err)
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, errors.New("no PKCS#11 private key matches the URI")
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, errors.New("more than one PKCS#11 private key matches the URI")
+	}
+}
+
+// Public implements crypto.Signer.
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign implements crypto.Signer.
+// RSA signatures use PKCS #1 v1.5 padding and ECDSA signatures are ASN.1 DER encoded, matching the signers for keys read from files.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.SHA256 {
+		return nil, fmt.Errorf("unsupported hash function %s", opts.HashFunc())
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch s.publicKey.(type) {
+	case *rsa.PublicKey:
+		data := append(append([]byte{}, sha256DigestInfoPrefix...), digest...)
+		return s.sign(pkcs11.CKM_RSA_PKCS, data)
+
+	case *ecdsa.PublicKey:
+		signature, err := s.sign(pkcs11.CKM_ECDSA, digest)
+		if err != nil {
+			return nil, err
+		}
+		if len(signature) == 0 || len(signature)%2 != 0 {
+			return nil, fmt.Errorf("invalid ECDSA signature length %d", len(signature))
+		}
+
+		n := len(signature) / 2
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{
+			R: new(big.Int).SetBytes(signature[:n]),
+			S: new(big.Int).SetBytes(signature[n:]),
+		})
+
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", s.publicKey)
+	}
+}
+
+func (s *pkcs11Signer) sign(mechanism uint, data []byte) ([]byte, error) {
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, s.key); err != nil {
+		return nil, fmt.Errorf("signing with PKCS#11 private key: %w", err)
+	}
+
+	signature, err := s.ctx.Sign(s.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("signing with PKCS#11 private key: %w", err)
+	}
+
+	return signature, nil
+}
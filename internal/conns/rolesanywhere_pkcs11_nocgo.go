@@ -0,0 +1,16 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !cgo
+
+package conns
+
+import (
+	"crypto"
+	"errors"
+)
+
+// newPKCS11Signer returns an error as PKCS#11 modules can only be loaded by binaries built with cgo.
+func newPKCS11Signer(*pkcs11URI, crypto.PublicKey) (crypto.Signer, error) {
+	return nil, errors.New("PKCS#11 private keys are not supported by this build of the provider, which was built without cgo")
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePKCS11URI(t *testing.T) {
+	t.Parallel()
+
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	if err := os.WriteFile(pinFile, []byte("5678\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	slotID := uint(3)
+
+	testCases := map[string]struct {
+		uri           string
+		expected      *pkcs11URI
+		expectedError bool
+	}{
+		"token and object": {
+			uri: "pkcs11:token=ci;object=ci-runner;type=private?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234",
+			expected: &pkcs11URI{
+				ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+				Object:     "ci-runner",
+				PIN:        "1234",
+				Token:      "ci",
+			},
+		},
+		"percent-encoded": {
+			uri: "pkcs11:manufacturer=SoftHSM%20project;model=SoftHSM%20v2;serial=0123;slot-id=3;id=%01%02?module-path=%2Fopt%2Fhsm%2Flib.so",
+			expected: &pkcs11URI{
+				ID:           []byte{1, 2},
+				Manufacturer: "SoftHSM project",
+				Model:        "SoftHSM v2",
+				ModulePath:   "/opt/hsm/lib.so",
+				Serial:       "0123",
+				SlotID:       &slotID,
+			},
+		},
+		"pin-source": {
+			uri: "pkcs11:object=ci-runner?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-source=file:" + pinFile,
+			expected: &pkcs11URI{
+				ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+				Object:     "ci-runner",
+				PIN:        "5678",
+			},
+		},
+		"no scheme": {
+			uri:           "token=ci?module-path=/usr/lib/softhsm/libsofthsm2.so",
+			expectedError: true,
+		},
+		"no module-path": {
+			uri:           "pkcs11:token=ci;object=ci-runner",
+			expectedError: true,
+		},
+		"public key": {
+			uri:           "pkcs11:object=ci-runner;type=public?module-path=/usr/lib/softhsm/libsofthsm2.so",
+			expectedError: true,
+		},
+		"invalid slot-id": {
+			uri:           "pkcs11:slot-id=one?module-path=/usr/lib/softhsm/libsofthsm2.so",
+			expectedError: true,
+		},
+		"invalid percent-encoding": {
+			uri:           "pkcs11:object=ci%2?module-path=/usr/lib/softhsm/libsofthsm2.so",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parsePKCS11URI(testCase.uri)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("parsePKCS11URI(%q) err %t, want %t (%v)", testCase.uri, got, want, err)
+			}
+			if err != nil {
+				if strings.Contains(err.Error(), testCase.uri) {
+					t.Errorf("error %q contains the URI", err)
+				}
+				return
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRolesAnywhereCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(123456789),
+		Subject:      pkix.Name{CommonName: "ci-runner"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certificateFile := filepath.Join(dir, "certificate.pem")
+	privateKeyFile := filepath.Join(dir, "private_key.pem")
+	if err := os.WriteFile(certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		profileARN     = "arn:aws:rolesanywhere:us-west-2:123456789012:profile/a1b2c3d4"      //lintignore:AWSAT003,AWSAT005
+		roleARN        = "arn:aws:iam::123456789012:role/ci"                                  //lintignore:AWSAT005
+		trustAnchorARN = "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/e5f6a7b8" //lintignore:AWSAT003,AWSAT005
+	)
+	expires := time.Date(2026, time.January, 2, 4, 4, 5, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/sessions"; got != want {
+			t.Errorf("path: got %q, want %q", got, want)
+		}
+		if got, want := r.Header.Get("X-Amz-X509"), base64.StdEncoding.EncodeToString(der); got != want {
+			t.Errorf("X-Amz-X509: got %q, want %q", got, want)
+		}
+
+		authorization := r.Header.Get("Authorization")
+		if prefix := "AWS4-X509-ECDSA-SHA256 Credential=123456789/20260102/us-west-2/rolesanywhere/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-x509, Signature="; !strings.HasPrefix(authorization, prefix) {
+			t.Errorf("Authorization: got %q, want prefix %q", authorization, prefix)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var input rolesAnywhereCreateSessionInput
+		if err := json.Unmarshal(body, &input); err != nil {
+			t.Errorf("unmarshaling request body: %s", err)
+		}
+		if got, want := input, (rolesAnywhereCreateSessionInput{DurationSeconds: 1800, ProfileARN: profileARN, RoleARN: roleARN, TrustAnchorARN: trustAnchorARN}); got != want {
+			t.Errorf("request body: got %+v, want %+v", got, want)
+		}
+
+		// Verify the signature independently of the signing code.
+		bodyHash := sha256.Sum256(body)
+		canonicalRequest := strings.Join([]string{
+			"POST",
+			"/sessions",
+			"",
+			"content-type:application/json\nhost:" + r.Host + "\nx-amz-date:20260102T030405Z\nx-amz-x509:" + r.Header.Get("X-Amz-X509") + "\n",
+			"content-type;host;x-amz-date;x-amz-x509",
+			hex.EncodeToString(bodyHash[:]),
+		}, "\n")
+		canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+		stringToSign := "AWS4-X509-ECDSA-SHA256\n20260102T030405Z\n20260102/us-west-2/rolesanywhere/aws4_request\n" + hex.EncodeToString(canonicalRequestHash[:])
+		digest := sha256.Sum256([]byte(stringToSign))
+		_, signature, _ := strings.Cut(authorization, "Signature=")
+		signatureBytes, _ := hex.DecodeString(signature)
+		if !ecdsa.VerifyASN1(&key.PublicKey, digest[:], signatureBytes) {
+			t.Errorf("signature %q does not verify", signature)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"credentialSet":[{"credentials":{"accessKeyId":"AKIAEXAMPLE","secretAccessKey":"secret","sessionToken":"token","expiration":"2026-01-02T04:04:05Z"}}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	provider, err := newRolesAnywhereCredentialsProvider(AssumeRoleWithCertificate{
+		CertificateFile: certificateFile,
+		Duration:        30 * time.Minute,
+		PrivateKeyFile:  privateKeyFile,
+		ProfileARN:      profileARN,
+		RoleARN:         roleARN,
+		TrustAnchorARN:  trustAnchorARN,
+	}, server.URL, server.Client())
+	if err != nil {
+		t.Fatalf("newRolesAnywhereCredentialsProvider: %s", err)
+	}
+	provider.now = func() time.Time {
+		return time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	credentials, err := provider.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("Retrieve: %s", err)
+	}
+
+	if got, want := credentials.AccessKeyID, "AKIAEXAMPLE"; got != want {
+		t.Errorf("AccessKeyID: got %q, want %q", got, want)
+	}
+	if got, want := credentials.SessionToken, "token"; got != want {
+		t.Errorf("SessionToken: got %q, want %q", got, want)
+	}
+	if !credentials.CanExpire || !credentials.Expires.Equal(expires) {
+		t.Errorf("Expires: got %s (CanExpire %t), want %s", credentials.Expires, credentials.CanExpire, expires)
+	}
+}
+
+func TestRolesAnywhereRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn           string
+		expected      string
+		expectedError bool
+	}{
+		"trust anchor": {
+			arn:      "arn:aws:rolesanywhere:eu-west-1:123456789012:trust-anchor/e5f6a7b8", //lintignore:AWSAT003,AWSAT005
+			expected: "eu-west-1",                                                          //lintignore:AWSAT003
+		},
+		"no Region": {
+			arn:           "arn:aws:rolesanywhere::123456789012:trust-anchor/e5f6a7b8", //lintignore:AWSAT005
+			expectedError: true,
+		},
+		"invalid ARN": {
+			arn:           "e5f6a7b8",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rolesAnywhereRegion(testCase.arn)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("rolesAnywhereRegion(%q) err %t, want %t (%v)", testCase.arn, got, want, err)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("rolesAnywhereRegion(%q) = %q, want %q", testCase.arn, got, want)
+			}
+		})
+	}
+}
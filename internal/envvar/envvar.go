@@ -78,6 +78,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For acceptance tests, enables a check that re-planning after applying and refreshing each step
+	// results in an empty plan, reporting any attribute changes
+	AccIdempotentReplan = "TF_ACC_IDEMPOTENT_REPLAN"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -56,6 +56,8 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 
 	diags.Append(autoExpandConvert(ctx, tfObject, apiObject, expander)...)
 
+	expander.Options.unmappedFields.log(ctx, tfObject, apiObject)
+
 	return diags
 }
 
@@ -71,6 +73,10 @@ func newAutoExpander(optFns []AutoFlexOptionsFunc) *autoExpander {
 		ignoredFieldNames: DefaultIgnoredFieldNames,
 	}
 
+	if reportUnmappedFieldsFromEnv() {
+		o.unmappedFields = newUnmappedFields()
+	}
+
 	for _, optFn := range optFns {
 		optFn(&o)
 	}
@@ -1409,6 +1415,8 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
+	mappedFieldNames := make(map[string]bool)
+
 	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
@@ -1435,9 +1443,11 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			flexer.getOptions().unmappedFields.addSourceField(sourcePath, fromFieldName)
 			continue
 		}
 		toFieldName := toField.Name
+		mappedFieldNames[toFieldName] = true
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
@@ -1466,6 +1476,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		}
 	}
 
+	// Target fields set by XML wrapper collapse or split aren't tracked.
+	if len(processedFields) == 0 && !diags.HasError() {
+		opts := flexer.getOptions()
+		opts.unmappedFields.addTargetFields(targetPath, typeTo, mappedFieldNames, opts)
+	}
+
 	return diags
 }
 
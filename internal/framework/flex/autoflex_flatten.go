@@ -49,6 +49,8 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 
 	diags.Append(autoFlattenConvert(ctx, apiObject, tfObject, flattener)...)
 
+	flattener.Options.unmappedFields.log(ctx, apiObject, tfObject)
+
 	return diags
 }
 
@@ -64,6 +66,10 @@ func newAutoFlattener(optFns []AutoFlexOptionsFunc) *autoFlattener {
 		ignoredFieldNames: DefaultIgnoredFieldNames,
 	}
 
+	if reportUnmappedFieldsFromEnv() {
+		o.unmappedFields = newUnmappedFields()
+	}
+
 	for _, optFn := range optFns {
 		optFn(&o)
 	}
@@ -2211,6 +2217,8 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	mappedFieldNames := make(map[string]bool)
+
 	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
@@ -2228,9 +2236,11 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			flexer.getOptions().unmappedFields.addSourceField(sourcePath, fromFieldName)
 			continue
 		}
 		toFieldName := toField.Name
+		mappedFieldNames[toFieldName] = true
 		toNameOverride, toFieldOpts := autoflexTags(toField)
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if toNameOverride == "-" {
@@ -2581,6 +2591,12 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		}
 	}
 
+	// Target fields set by XML wrapper collapse or split aren't tracked.
+	if len(processedFields) == 0 && !diags.HasError() {
+		opts := flexer.getOptions()
+		opts.unmappedFields.addTargetFields(targetPath, typeTo, mappedFieldNames, opts)
+	}
+
 	return diags
 }
 
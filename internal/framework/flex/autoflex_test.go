@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -98,7 +99,13 @@ func runAutoExpandTestCases(t *testing.T, testCases autoFlexTestCases, checks ru
 			ctx = tflogtest.RootLogger(ctx, &buf)
 			ctx = registerTestingLogger(ctx)
 
-			diags := Expand(ctx, tc.Source, tc.Target, tc.Options...)
+			options := tc.Options
+			if checks.GoldenLogs {
+				// Any change to the unmapped fields is a golden snapshot difference.
+				options = append(slices.Clone(options), WithUnmappedFieldsReport())
+			}
+
+			diags := Expand(ctx, tc.Source, tc.Target, options...)
 
 			if checks.CompareDiags {
 				if diff := cmp.Diff(diags, tc.ExpectedDiags); diff != "" {
@@ -151,7 +158,13 @@ func runAutoFlattenTestCases(t *testing.T, testCases autoFlexTestCases, checks r
 			ctx = tflogtest.RootLogger(ctx, &buf)
 			ctx = registerTestingLogger(ctx)
 
-			diags := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
+			options := testCase.Options
+			if checks.GoldenLogs {
+				// Any change to the unmapped fields is a golden snapshot difference.
+				options = append(slices.Clone(options), WithUnmappedFieldsReport())
+			}
+
+			diags := Flatten(ctx, testCase.Source, testCase.Target, options...)
 
 			if checks.CompareDiags {
 				if diff := cmp.Diff(diags, testCase.ExpectedDiags); diff != "" {
@@ -218,8 +231,14 @@ func runTopLevelTestCases[Tsource, Ttarget any](t *testing.T, testCases toplevel
 
 			ctx = registerTestingLogger(ctx)
 
+			var options []AutoFlexOptionsFunc
+			if checks.GoldenLogs {
+				// Any change to the unmapped fields is a golden snapshot difference.
+				options = append(options, WithUnmappedFieldsReport())
+			}
+
 			var target Ttarget
-			diags := Flatten(ctx, testCase.source, &target)
+			diags := Flatten(ctx, testCase.source, &target, options...)
 
 			if checks.CompareDiags {
 				if diff := cmp.Diff(diags, testCase.ExpectedDiags); diff != "" {
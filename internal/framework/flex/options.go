@@ -27,6 +27,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unmappedFields records the fields which expanders and flatteners
+	// skip, if reporting is enabled
+	unmappedFields *unmappedFields
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +81,17 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnmappedFieldsReport enables a report of unmapped fields
+//
+// Use this option to find source fields without a corresponding target field,
+// and target fields that are not set, e.g. after an AWS SDK for Go v2 upgrade
+// adds or renames a field. The report is logged as a warning.
+func WithUnmappedFieldsReport() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.unmappedFields = newUnmappedFields()
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+const (
+	logAttrKeyUnmappedSourceFields = "autoflex.unmapped.source_fields"
+	logAttrKeyUnmappedTargetFields = "autoflex.unmapped.target_fields"
+)
+
+const (
+	envvarReportUnmappedFields = "TF_AWS_AUTOFLEX_REPORT_UNMAPPED_FIELDS"
+)
+
+var (
+	// unreportedFieldNames are the names of fields which are expected to have no corresponding field.
+	unreportedFieldNames = []string{
+		"Region",         // Resource Region is handled separately.
+		"ResultMetadata", // AWS SDK for Go v2 operation output metadata.
+		"TagsAll",        // Resource tags are handled separately.
+		"Timeouts",       // Resource timeouts are handled separately.
+	}
+)
+
+// unmappedFields records the fields skipped during a single Expand or Flatten call.
+type unmappedFields struct {
+	sourceFields []string // Paths of source fields without a corresponding target field.
+	targetFields []string // Paths of target fields not set from a source field.
+}
+
+func newUnmappedFields() *unmappedFields {
+	return &unmappedFields{
+		sourceFields: []string{},
+		targetFields: []string{},
+	}
+}
+
+func reportUnmappedFieldsFromEnv() bool {
+	return os.Getenv(envvarReportUnmappedFields) != ""
+}
+
+// addSourceField records a source field without a corresponding target field.
+func (u *unmappedFields) addSourceField(sourcePath path.Path, fieldName string) {
+	if u == nil || slices.Contains(unreportedFieldNames, fieldName) {
+		return
+	}
+
+	u.sourceFields = append(u.sourceFields, sourcePath.AtName(fieldName).String())
+}
+
+// addTargetFields records the fields of target struct type `typ` that were not set from a source field.
+func (u *unmappedFields) addTargetFields(targetPath path.Path, typ reflect.Type, mappedFieldNames map[string]bool, opts AutoFlexOptions) {
+	if u == nil {
+		return
+	}
+
+	for field := range tfreflect.ExportedStructFields(typ) {
+		fieldName := field.Name
+		if mappedFieldNames[fieldName] || fieldName == mapBlockKeyFieldName || opts.isIgnoredField(fieldName) || slices.Contains(unreportedFieldNames, fieldName) {
+			continue
+		}
+
+		if nameOverride, fieldOpts := autoflexTags(field); nameOverride == "-" || fieldOpts.NoFlatten() {
+			continue
+		}
+
+		u.targetFields = append(u.targetFields, targetPath.AtName(fieldName).String())
+	}
+}
+
+// log emits a warning listing any unmapped fields.
+func (u *unmappedFields) log(ctx context.Context, from, to any) {
+	if u == nil || (len(u.sourceFields) == 0 && len(u.targetFields) == 0) {
+		return
+	}
+
+	slices.Sort(u.sourceFields)
+	slices.Sort(u.targetFields)
+
+	tflog.Warn(ctx, "AutoFlex unmapped fields", map[string]any{
+		logAttrKeySourceType:           fullTypeName(reflect.TypeOf(from)),
+		logAttrKeyTargetType:           fullTypeName(reflect.TypeOf(to)),
+		logAttrKeyUnmappedSourceFields: u.sourceFields,
+		logAttrKeyUnmappedTargetFields: u.targetFields,
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfUnmappedFields struct {
+	Field1   types.String                                            `tfsdk:"field1"`
+	Field2   types.String                                            `tfsdk:"field2"`
+	Field3   types.String                                            `tfsdk:"field3" autoflex:"-"`
+	Field4   types.String                                            `tfsdk:"field4" autoflex:",noflatten"`
+	Nested   fwtypes.ListNestedObjectValueOf[tfUnmappedFieldsNested] `tfsdk:"nested"`
+	Region   types.String                                            `tfsdk:"region"`
+	Tags     fwtypes.MapOfString                                     `tfsdk:"tags"`
+	TagsAll  fwtypes.MapOfString                                     `tfsdk:"tags_all"`
+	Timeouts timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+type tfUnmappedFieldsNested struct {
+	Field5 types.String `tfsdk:"field5"`
+	Field6 types.String `tfsdk:"field6"`
+}
+
+type awsUnmappedFields struct {
+	Field1 *string
+	Field7 *string
+	Nested *awsUnmappedFieldsNested
+}
+
+type awsUnmappedFieldsNested struct {
+	Field5 *string
+	Field8 *string
+}
+
+func TestUnmappedFieldsReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		expand       bool
+		options      []AutoFlexOptionsFunc
+		wantLogLines []map[string]any
+	}{
+		"expand disabled": {
+			expand: true,
+		},
+		"expand": {
+			expand:  true,
+			options: []AutoFlexOptionsFunc{WithUnmappedFieldsReport()},
+			wantLogLines: []map[string]any{
+				{
+					"@level":                       "warn",
+					"@message":                     "AutoFlex unmapped fields",
+					"@module":                      "provider",
+					logAttrKeySourceType:           "github.com/hashicorp/terraform-provider-aws/internal/framework/flex.tfUnmappedFields",
+					logAttrKeyTargetType:           "*github.com/hashicorp/terraform-provider-aws/internal/framework/flex.awsUnmappedFields",
+					logAttrKeyUnmappedSourceFields: []any{"Field2", "Field4", "Nested[0].Field6"},
+					logAttrKeyUnmappedTargetFields: []any{"Field7", "Nested.Field8"},
+				},
+			},
+		},
+		"flatten disabled": {},
+		"flatten": {
+			options: []AutoFlexOptionsFunc{WithUnmappedFieldsReport()},
+			wantLogLines: []map[string]any{
+				{
+					"@level":                       "warn",
+					"@message":                     "AutoFlex unmapped fields",
+					"@module":                      "provider",
+					logAttrKeySourceType:           "github.com/hashicorp/terraform-provider-aws/internal/framework/flex.awsUnmappedFields",
+					logAttrKeyTargetType:           "*github.com/hashicorp/terraform-provider-aws/internal/framework/flex.tfUnmappedFields",
+					logAttrKeyUnmappedSourceFields: []any{"Field7", "Nested.Field8"},
+					logAttrKeyUnmappedTargetFields: []any{"Field2", "Nested.Field6"},
+				},
+			},
+		},
+		"flatten ignored field": {
+			options: []AutoFlexOptionsFunc{WithUnmappedFieldsReport(), WithIgnoredFieldNamesAppend("Field2"), WithIgnoredFieldNamesAppend("Field7")},
+			wantLogLines: []map[string]any{
+				{
+					"@level":                       "warn",
+					"@message":                     "AutoFlex unmapped fields",
+					"@module":                      "provider",
+					logAttrKeySourceType:           "github.com/hashicorp/terraform-provider-aws/internal/framework/flex.awsUnmappedFields",
+					logAttrKeyTargetType:           "*github.com/hashicorp/terraform-provider-aws/internal/framework/flex.tfUnmappedFields",
+					logAttrKeyUnmappedSourceFields: []any{"Nested.Field8"},
+					logAttrKeyUnmappedTargetFields: []any{"Nested.Field6"},
+				},
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			ctx := tflogtest.RootLogger(ctx, &buf)
+
+			if testCase.expand {
+				tfObject := tfUnmappedFields{
+					Field1: types.StringValue("value1"),
+					Field2: types.StringValue("value2"),
+					Field4: types.StringValue("value4"),
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnmappedFieldsNested{
+						Field5: types.StringValue("value5"),
+						Field6: types.StringValue("value6"),
+					}),
+				}
+				var apiObject awsUnmappedFields
+
+				if diags := Expand(ctx, tfObject, &apiObject, testCase.options...); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			} else {
+				apiObject := awsUnmappedFields{
+					Field1: aws.String("value1"),
+					Field7: aws.String("value7"),
+					Nested: &awsUnmappedFieldsNested{
+						Field5: aws.String("value5"),
+						Field8: aws.String("value8"),
+					},
+				}
+				var tfObject tfUnmappedFields
+
+				if diags := Flatten(ctx, apiObject, &tfObject, testCase.options...); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+			}
+
+			lines, err := tflogtest.MultilineJSONDecode(&buf)
+			if err != nil {
+				t.Fatalf("decoding log lines: %s", err)
+			}
+
+			// Only the provider root logger's lines are of interest.
+			lines = slices.DeleteFunc(lines, func(line map[string]any) bool {
+				return line["@module"] != "provider"
+			})
+
+			if diff := cmp.Diff(lines, testCase.wantLogLines, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected log lines difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ARNLister is implemented by list resources which can produce a list result
+// from nothing more than a resource's ARN, e.g. an ARN returned by the
+// Resource Groups Tagging API.
+type ARNLister interface {
+	// CanListByARN returns whether the list resource is able to produce list results by ARN.
+	CanListByARN() bool
+	// ListResultFromARN populates `result` from the resource with the specified ARN.
+	// It returns false if the resource cannot be read, no longer exists or is excluded from the list.
+	ListResultFromARN(ctx context.Context, awsClient *conns.AWSClient, arn string, includeResource bool, result *list.ListResult) bool
+}
+
+var _ ARNLister = &ListResourceWithSDKv2Resource{}
+
+// SetImportIDFromARN sets the function used to derive a resource's import ID from its ARN.
+// It is only needed for resources with a single-parameter identity; resources with an ARN identity
+// are imported by ARN.
+func (l *listResourceWithSDKv2Resource[T]) SetImportIDFromARN(f func(arn.ARN) (string, error)) {
+	l.importIDFromARN = f
+}
+
+// SetExcludeFromARN sets the function used to exclude resources listed by ARN, e.g. default resources
+// which the list resource's own List implementation does not return.
+// The function is called with the resource's data after it has been read.
+func (l *listResourceWithSDKv2Resource[T]) SetExcludeFromARN(f func(context.Context, *conns.AWSClient, *schema.ResourceData) (bool, error)) {
+	l.excludeFromARN = f
+}
+
+// SetDisplayName sets the function used to derive a list result's display name from a resource listed by ARN.
+// The function is called with the resource's data after any result interceptors, e.g. for tags, have run.
+// By default the resource's name, or if it has none its ARN, is displayed.
+func (l *listResourceWithSDKv2Resource[T]) SetDisplayName(f func(*schema.ResourceData) string) {
+	l.displayName = f
+}
+
+func (l *listResourceWithSDKv2Resource[T]) CanListByARN() bool {
+	if l.identitySpec.IsARN {
+		return true
+	}
+
+	return l.identitySpec.IsSingleParameter && l.importIDFromARN != nil
+}
+
+func (l *listResourceWithSDKv2Resource[T]) ListResultFromARN(ctx context.Context, awsClient *conns.AWSClient, arn string, includeResource bool, result *list.ListResult) bool {
+	ctx = tflog.SetField(ctx, names.AttrARN, arn)
+
+	rd, err := l.resourceDataFromARN(ctx, awsClient, arn)
+	if err != nil {
+		tflog.Error(ctx, "Importing resource by ARN", map[string]any{
+			names.AttrARN: arn,
+			"error":       err.Error(),
+		})
+		return false
+	}
+
+	tflog.Info(ctx, "Reading resource")
+	diags := l.read(ctx, rd, awsClient)
+	if diags.HasError() || rd.Id() == "" {
+		// Resource can't be read or is logically deleted.
+		// Log and continue.
+		tflog.Error(ctx, "Reading resource", map[string]any{
+			names.AttrARN: arn,
+			"diags":       sdkdiag.DiagnosticsString(diags),
+		})
+		return false
+	}
+
+	if f := l.excludeFromARN; f != nil {
+		exclude, err := f(ctx, awsClient, rd)
+		if err != nil {
+			tflog.Error(ctx, "Reading resource", map[string]any{
+				names.AttrARN: arn,
+				"error":       err.Error(),
+			})
+			return false
+		}
+		if exclude {
+			tflog.Debug(ctx, "Excluding resource")
+			return false
+		}
+	}
+
+	l.SetResult(ctx, awsClient, includeResource, result, rd)
+	if result.Diagnostics.HasError() {
+		return true
+	}
+
+	if f := l.displayName; f != nil {
+		result.DisplayName = f(rd)
+	} else {
+		result.DisplayName = arn
+		if v, ok := rd.GetOk(names.AttrName); ok {
+			if v, ok := v.(string); ok {
+				result.DisplayName = v
+			}
+		}
+	}
+
+	return true
+}
+
+// resourceDataFromARN returns resource data with the resource's identifying attributes set as though
+// the resource had been imported by ARN.
+func (l *listResourceWithSDKv2Resource[T]) resourceDataFromARN(ctx context.Context, awsClient *conns.AWSClient, arnValue string) (*schema.ResourceData, error) {
+	arnARN, err := arn.Parse(arnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	rd := l.ResourceData()
+
+	switch {
+	case l.identitySpec.IsARN:
+		rd.SetId(arnValue)
+
+		if v := l.resourceSchema.Importer; v != nil && v.StateContext != nil {
+			// Custom importers retrieve the identity specification from the context.
+			if _, err := v.StateContext(importer.Context(ctx, &l.identitySpec, nil), rd, awsClient); err != nil {
+				return nil, err
+			}
+		} else if l.identitySpec.IsGlobalResource {
+			if err := importer.GlobalARN(ctx, rd, l.identitySpec); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := importer.RegionalARN(ctx, rd, l.identitySpec); err != nil {
+				return nil, err
+			}
+		}
+
+	case l.identitySpec.IsSingleParameter && l.importIDFromARN != nil:
+		id, err := l.importIDFromARN(arnARN)
+		if err != nil {
+			return nil, err
+		}
+		rd.SetId(id)
+
+		if l.identitySpec.IsGlobalResource {
+			if err := importer.GlobalSingleParameterized(ctx, rd, l.identitySpec, awsClient); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := importer.RegionalSingleParameterized(ctx, rd, l.identitySpec, awsClient); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("listing by ARN is not supported")
+	}
+
+	return rd, nil
+}
+
+func (l *listResourceWithSDKv2Resource[T]) read(ctx context.Context, rd *schema.ResourceData, awsClient *conns.AWSClient) diag.Diagnostics {
+	switch r := l.resourceSchema; {
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout(ctx, rd, awsClient)
+	case r.ReadContext != nil:
+		return r.ReadContext(ctx, rd, awsClient)
+	default:
+		return diag.Errorf("resource has no Read function")
+	}
+}
+
+// ImportIDFromARNResource returns a function which derives a resource's import ID
+// by removing the specified prefix from the resource part of its ARN.
+func ImportIDFromARNResource(prefix string) func(arn.ARN) (string, error) {
+	return func(v arn.ARN) (string, error) {
+		id, ok := strings.CutPrefix(v.Resource, prefix)
+		if !ok || id == "" {
+			return "", fmt.Errorf("unexpected format for ARN resource (%s), expected %s<id>", v.Resource, prefix)
+		}
+
+		return id, nil
+	}
+}
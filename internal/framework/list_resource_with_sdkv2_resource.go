@@ -8,6 +8,7 @@ import (
 	"slices"
 	"unique"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,11 +32,14 @@ type ListResourceWithSDKv2Resource = listResourceWithSDKv2Resource[listresource.
 type listResourceWithSDKv2Resource[T listresource.InterceptorParamsSDK] struct {
 	withListResourceConfigSchema
 	ResourceWithConfigure
-	resourceSchema *schema.Resource
-	identitySpec   inttypes.Identity
-	identitySchema *schema.ResourceIdentity
-	regionSpec     unique.Handle[inttypes.ServicePackageResourceRegion]
-	interceptors   []listresource.ListResultInterceptor[T]
+	resourceSchema  *schema.Resource
+	identitySpec    inttypes.Identity
+	identitySchema  *schema.ResourceIdentity
+	regionSpec      unique.Handle[inttypes.ServicePackageResourceRegion]
+	interceptors    []listresource.ListResultInterceptor[T]
+	importIDFromARN func(arn.ARN) (string, error)
+	excludeFromARN  func(context.Context, *conns.AWSClient, *schema.ResourceData) (bool, error)
+	displayName     func(*schema.ResourceData) string
 }
 
 func (l *listResourceWithSDKv2Resource[T]) AppendResultInterceptor(interceptor listresource.ListResultInterceptor[T]) {
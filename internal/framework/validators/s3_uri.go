@@ -11,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// S3BucketNamePattern matches the name of a general purpose S3 bucket in an S3 URI.
+const S3BucketNamePattern = `[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9]`
+
 // s3URIValidator validates that a string Attribute's value is a valid S3 URI.
 type s3URIValidator struct{}
 
@@ -27,7 +30,7 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 		return
 	}
 
-	if !regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
+	if !regexache.MustCompile(`^s3://` + S3BucketNamePattern + `(/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
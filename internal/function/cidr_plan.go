@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrPlanFunction{}
+
+func NewCIDRPlanFunction() function.Function {
+	return &cidrPlanFunction{}
+}
+
+type cidrPlanFunction struct{}
+
+func (f cidrPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_plan"
+}
+
+func (f cidrPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_plan Function",
+		MarkdownDescription: "Allocates CIDR blocks of the requested prefix lengths from a network, skipping " +
+			"CIDR blocks that are already in use. Each allocation is the lowest-addressed free block of the " +
+			"requested size, and allocations are made in the order requested.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to allocate from, for example a VPC CIDR block",
+			},
+			function.ListParameter{
+				Name:                "used_cidr_blocks",
+				MarkdownDescription: "CIDR blocks that are already allocated and must not be overlapped",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				MarkdownDescription: "Prefix lengths of the CIDR blocks to allocate",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var usedCIDRBlocks []string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &usedCIDRBlocks, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := inttypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	network := netip.MustParsePrefix(cidrBlock).Masked()
+
+	used := make([]netip.Prefix, 0, len(usedCIDRBlocks)+len(prefixLengths))
+	for _, v := range usedCIDRBlocks {
+		if err := inttypes.ValidateCIDRBlock(v); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+			return
+		}
+		prefix := netip.MustParsePrefix(v).Masked()
+		if prefix.Addr().Is4() != network.Addr().Is4() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("%q is not in the same address family as %q", v, cidrBlock)))
+			return
+		}
+		used = append(used, prefix)
+	}
+
+	result, err := cidrPlan(network, used, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrPlan allocates, in order, the lowest-addressed block of each requested
+// prefix length within network that does not overlap any used or previously
+// allocated block.
+func cidrPlan(network netip.Prefix, used []netip.Prefix, prefixLengths []int64) ([]string, error) {
+	bitLen := network.Addr().BitLen()
+	start := addrToInt(network.Addr())
+	end := new(big.Int).Add(start, blockSize(bitLen, network.Bits()))
+
+	result := make([]string, 0, len(prefixLengths))
+	for i, prefixLength := range prefixLengths {
+		if prefixLength < int64(network.Bits()) || prefixLength > int64(bitLen) {
+			return nil, fmt.Errorf("prefix_lengths[%d]: %d must be between %d and %d", i, prefixLength, network.Bits(), bitLen)
+		}
+
+		size := blockSize(bitLen, int(prefixLength))
+		candidate := new(big.Int).Set(start)
+
+	candidates:
+		for {
+			if new(big.Int).Add(candidate, size).Cmp(end) > 0 {
+				return nil, fmt.Errorf("prefix_lengths[%d]: insufficient free address space in %s for a /%d block", i, network, prefixLength)
+			}
+
+			for _, prefix := range used {
+				usedStart := addrToInt(prefix.Addr())
+				usedEnd := new(big.Int).Add(usedStart, blockSize(bitLen, prefix.Bits()))
+				candidateEnd := new(big.Int).Add(candidate, size)
+
+				if candidate.Cmp(usedEnd) < 0 && usedStart.Cmp(candidateEnd) < 0 {
+					// Advance to the first aligned candidate after the overlapping block.
+					candidate = usedEnd.Add(usedEnd, new(big.Int).Sub(size, big.NewInt(1)))
+					candidate.Div(candidate, size).Mul(candidate, size)
+					continue candidates
+				}
+			}
+
+			break
+		}
+
+		allocated := netip.PrefixFrom(intToAddr(candidate, bitLen), int(prefixLength))
+		used = append(used, allocated)
+		result = append(result, allocated.String())
+	}
+
+	return result, nil
+}
+
+// blockSize returns the number of addresses in a block with the specified prefix length.
+func blockSize(bitLen, prefixLength int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(bitLen-prefixLength))
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func intToAddr(v *big.Int, bitLen int) netip.Addr {
+	b := v.FillBytes(make([]byte, bitLen/8))
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
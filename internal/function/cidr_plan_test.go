@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRPlanFunctionConfig("10.0.0.0/16", `["10.0.0.0/24", "10.0.2.0/23"]`, `[24, 24, 20]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.1.0/24,10.0.4.0/24,10.0.16.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRPlanFunctionConfig("2600:1f14::/56", `["2600:1f14::/64"]`, `[64, 60]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:0:1::/64,2600:1f14:0:10::/60"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRPlanFunctionConfig("10.0.0.0/24", `["10.0.0.0/25"]`, `[26, 26, 26]`),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*free[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRPlanFunctionConfig("10.0.0.0/16", `[]`, `[8]`),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func TestCIDRPlanFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRPlanFunctionConfig("10.0.0.1/16", `[]`, `[24]`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDRPlanFunctionConfig(cidrBlock, usedCIDRBlocks, prefixLengths string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_plan(%[1]q, %[2]s, %[3]s))
+}
+`, cidrBlock, usedCIDRBlocks, prefixLengths)
+}
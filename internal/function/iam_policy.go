@@ -0,0 +1,463 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyDocument is the canonical representation of an IAM policy document.
+// Field order determines the order of elements in the marshaled JSON, with
+// Version first as required by IAM's legacy policy parser.
+type iamPolicyDocument struct {
+	Version    string                `json:"Version,omitempty"`
+	Id         string                `json:"Id,omitempty"` //nolint:revive // IAM policy element name
+	Statements []*iamPolicyStatement `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string         `json:"Sid,omitempty"`
+	Effect       string         `json:"Effect,omitempty"`
+	Principal    any            `json:"Principal,omitempty"`
+	NotPrincipal any            `json:"NotPrincipal,omitempty"`
+	Action       any            `json:"Action,omitempty"`
+	NotAction    any            `json:"NotAction,omitempty"`
+	Resource     any            `json:"Resource,omitempty"`
+	NotResource  any            `json:"NotResource,omitempty"`
+	Condition    map[string]any `json:"Condition,omitempty"`
+}
+
+// parseIAMPolicy parses and canonicalizes a JSON IAM policy document.
+func parseIAMPolicy(s string) (*iamPolicyDocument, error) {
+	var raw struct {
+		Version   string          `json:"Version"`
+		Id        string          `json:"Id"` //nolint:revive // IAM policy element name
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &iamPolicyDocument{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	var rawStatements []map[string]any
+	switch trimmed := strings.TrimSpace(string(raw.Statement)); {
+	case trimmed == "", trimmed == "null":
+	case strings.HasPrefix(trimmed, "{"):
+		var v map[string]any
+		if err := unmarshalIAMPolicyJSON(raw.Statement, &v); err != nil {
+			return nil, fmt.Errorf("parsing policy Statement: %w", err)
+		}
+		rawStatements = append(rawStatements, v)
+	default:
+		if err := unmarshalIAMPolicyJSON(raw.Statement, &rawStatements); err != nil {
+			return nil, fmt.Errorf("parsing policy Statement: %w", err)
+		}
+	}
+
+	for i, v := range rawStatements {
+		statement, err := newIAMPolicyStatement(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing policy Statement[%d]: %w", i, err)
+		}
+		doc.Statements = append(doc.Statements, statement)
+	}
+
+	return doc, nil
+}
+
+// unmarshalIAMPolicyJSON decodes JSON, preserving numbers as json.Number.
+func unmarshalIAMPolicyJSON(b []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
+
+// marshalIAMPolicyJSON returns the JSON encoding of v.
+// Unlike json.Marshal, '<', '>' and '&' are not escaped.
+func marshalIAMPolicyJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func newIAMPolicyStatement(m map[string]any) (*iamPolicyStatement, error) {
+	statement := &iamPolicyStatement{}
+
+	for k, v := range m {
+		var err error
+
+		switch k {
+		case "Sid":
+			statement.Sid, err = iamPolicyString(k, v)
+		case "Effect":
+			statement.Effect, err = iamPolicyString(k, v)
+		case "Principal":
+			statement.Principal, err = canonicalIAMPolicyPrincipal(v)
+		case "NotPrincipal":
+			statement.NotPrincipal, err = canonicalIAMPolicyPrincipal(v)
+		case "Action":
+			statement.Action, err = canonicalIAMPolicyStringSet(k, v)
+		case "NotAction":
+			statement.NotAction, err = canonicalIAMPolicyStringSet(k, v)
+		case "Resource":
+			statement.Resource, err = canonicalIAMPolicyStringSet(k, v)
+		case "NotResource":
+			statement.NotResource, err = canonicalIAMPolicyStringSet(k, v)
+		case "Condition":
+			statement.Condition, err = canonicalIAMPolicyCondition(v)
+		default:
+			err = fmt.Errorf("unsupported element %q", k)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return statement, nil
+}
+
+func iamPolicyString(k string, v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected string, got %T", k, v)
+	}
+
+	return s, nil
+}
+
+// canonicalIAMPolicyStringSet returns a single string, or a sorted list of unique strings.
+func canonicalIAMPolicyStringSet(k string, v any) (any, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case string:
+		values = []string{v}
+	case []string:
+		values = slices.Clone(v)
+	case []any:
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected string, got %T", k, v)
+			}
+			values = append(values, s)
+		}
+	default:
+		return nil, fmt.Errorf("%s: expected string or list of strings, got %T", k, v)
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+func canonicalIAMPolicyPrincipal(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf(`Principal: expected "*" or object, got %q`, v)
+		}
+		return v, nil
+	case map[string]any:
+		principal := make(map[string]any, len(v))
+		for typ, identifiers := range v {
+			value, err := canonicalIAMPolicyStringSet("Principal."+typ, identifiers)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				principal[typ] = value
+			}
+		}
+		return principal, nil
+	default:
+		return nil, fmt.Errorf("Principal: expected string or object, got %T", v)
+	}
+}
+
+func canonicalIAMPolicyCondition(v any) (map[string]any, error) {
+	operators, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("Condition: expected object, got %T", v)
+	}
+
+	condition := make(map[string]any, len(operators))
+	for operator, v := range operators {
+		keys, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("Condition.%s: expected object, got %T", operator, v)
+		}
+
+		block := make(map[string]any, len(keys))
+		for key, v := range keys {
+			value, err := canonicalIAMPolicyConditionValues("Condition."+operator+"."+key, v)
+			if err != nil {
+				return nil, err
+			}
+			block[key] = value
+		}
+		condition[operator] = block
+	}
+
+	return condition, nil
+}
+
+// canonicalIAMPolicyConditionValues returns a single condition value, or a sorted list of unique values.
+// Condition values may be strings, booleans or numbers, e.g. `"Bool":{"aws:SecureTransport":[false]}`.
+func canonicalIAMPolicyConditionValues(k string, v any) (any, error) {
+	var values []any
+
+	switch v := v.(type) {
+	case string, bool, json.Number:
+		values = []any{v}
+	case []any:
+		for _, v := range v {
+			switch v.(type) {
+			case string, bool, json.Number:
+			default:
+				return nil, fmt.Errorf("%s: expected string, boolean or number, got %T", k, v)
+			}
+			values = append(values, v)
+		}
+	default:
+		return nil, fmt.Errorf("%s: expected string, boolean, number or list, got %T", k, v)
+	}
+
+	slices.SortFunc(values, compareIAMPolicyConditionValues)
+	values = slices.CompactFunc(values, func(a, b any) bool {
+		return compareIAMPolicyConditionValues(a, b) == 0
+	})
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+// compareIAMPolicyConditionValues orders booleans before numbers before strings, and values of the same type by value.
+func compareIAMPolicyConditionValues(a, b any) int {
+	rank := func(v any) int {
+		switch v.(type) {
+		case bool:
+			return 0
+		case json.Number:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+		return c
+	}
+
+	switch a := a.(type) {
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case json.Number:
+		b := b.(json.Number)
+		x, errX := a.Float64()
+		y, errY := b.Float64()
+		if errX == nil && errY == nil {
+			if c := cmp.Compare(x, y); c != 0 {
+				return c
+			}
+		}
+		return strings.Compare(a.String(), b.String())
+	default:
+		return strings.Compare(a.(string), b.(string))
+	}
+}
+
+func (s *iamPolicyStatement) json() (string, error) {
+	b, err := marshalIAMPolicyJSON(&iamPolicyDocument{Statements: []*iamPolicyStatement{s}})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// equivalent returns whether two statements are semantically equivalent.
+func (s *iamPolicyStatement) equivalent(o *iamPolicyStatement) bool {
+	s1, err := s.json()
+	if err != nil {
+		return false
+	}
+
+	s2, err := o.json()
+	if err != nil {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(s1, s2)
+}
+
+// merge merges another statement with the same Sid into this statement.
+// Principals and actions are combined; all other elements must match.
+func (s *iamPolicyStatement) merge(o *iamPolicyStatement) error {
+	if s.Effect != o.Effect ||
+		!reflect.DeepEqual(s.Resource, o.Resource) ||
+		!reflect.DeepEqual(s.NotResource, o.NotResource) ||
+		!reflect.DeepEqual(s.NotAction, o.NotAction) ||
+		!reflect.DeepEqual(s.NotPrincipal, o.NotPrincipal) ||
+		!reflect.DeepEqual(s.Condition, o.Condition) {
+		return fmt.Errorf("statements with Sid %q have conflicting Effect, Resource, NotResource, NotAction, NotPrincipal or Condition elements", s.Sid)
+	}
+
+	if (s.Action == nil) != (o.Action == nil) {
+		return fmt.Errorf("statements with Sid %q cannot mix Action and NotAction", s.Sid)
+	}
+	if s.Action != nil {
+		action, err := canonicalIAMPolicyStringSet("Action", append(iamPolicyStrings(s.Action), iamPolicyStrings(o.Action)...))
+		if err != nil {
+			return err
+		}
+		s.Action = action
+	}
+
+	principal, err := mergeIAMPolicyPrincipals(s.Principal, o.Principal)
+	if err != nil {
+		return fmt.Errorf("statements with Sid %q: %w", s.Sid, err)
+	}
+	s.Principal = principal
+
+	return nil
+}
+
+func mergeIAMPolicyPrincipals(p1, p2 any) (any, error) {
+	switch {
+	case p1 == nil && p2 == nil:
+		return nil, nil
+	case p1 == nil || p2 == nil:
+		return nil, errors.New("cannot merge statement with Principal and statement without Principal")
+	case p1 == "*" || p2 == "*":
+		return "*", nil
+	}
+
+	m1, m2 := p1.(map[string]any), p2.(map[string]any)
+	principal := maps.Clone(m1)
+	for typ, identifiers := range m2 {
+		value, err := canonicalIAMPolicyStringSet("Principal."+typ, append(iamPolicyStrings(principal[typ]), iamPolicyStrings(identifiers)...))
+		if err != nil {
+			return nil, err
+		}
+		principal[typ] = value
+	}
+
+	return principal, nil
+}
+
+func iamPolicyStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	default:
+		return nil
+	}
+}
+
+// mergeIAMPolicies merges policy documents in order.
+// Statements sharing a Sid are combined, and statements without a Sid are
+// dropped if they are equivalent to a statement already in the result.
+func mergeIAMPolicies(docs ...*iamPolicyDocument) (*iamPolicyDocument, error) {
+	result := &iamPolicyDocument{}
+
+	for _, doc := range docs {
+		if doc.Id != "" {
+			result.Id = doc.Id
+		}
+		if doc.Version > result.Version {
+			result.Version = doc.Version
+		}
+
+	statements:
+		for _, statement := range doc.Statements {
+			for _, existing := range result.Statements {
+				if statement.Sid != "" && existing.Sid == statement.Sid {
+					if err := existing.merge(statement); err != nil {
+						return nil, err
+					}
+					continue statements
+				}
+
+				if statement.Sid == "" && existing.Sid == "" && existing.equivalent(statement) {
+					continue statements
+				}
+			}
+
+			result.Statements = append(result.Statements, statement)
+		}
+	}
+
+	return result, nil
+}
+
+func (d *iamPolicyDocument) String() (string, error) {
+	if d.Statements == nil {
+		d.Statements = []*iamPolicyStatement{}
+	}
+
+	b, err := marshalIAMPolicyJSON(d)
+	if err != nil {
+		return "", fmt.Errorf("marshaling policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// normalizeIAMPolicy returns the canonical JSON form of an IAM policy document.
+func normalizeIAMPolicy(s string) (string, error) {
+	doc, err := parseIAMPolicy(s)
+	if err != nil {
+		return "", err
+	}
+
+	return doc.String()
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	s3URIScheme = "s3://"
+)
+
+var (
+	s3BucketNameRegexp = regexache.MustCompile(`^` + fwvalidators.S3BucketNamePattern + `$`)
+
+	// <bucket>--<zone-id>--x-s3.s3express-<zone-id>.<region>.amazonaws.com
+	s3DirectoryBucketHostRegexp = regexache.MustCompile(`^(?P<bucket>[a-z0-9][\-a-z0-9]*--[a-z0-9\-]+--x-s3)\.s3express-(?:fips-)?[a-z0-9\-]+\.(?P<region>[a-z0-9\-]+)\.amazonaws\.com(?:\.cn)?$`)
+	// <access-point-name>-<account-id>.<outpost-id>.s3-outposts.<region>.amazonaws.com
+	s3OutpostsAccessPointHostRegexp = regexache.MustCompile(`^(?P<name>[a-z0-9][\-a-z0-9]*)-(?P<account>\d{12})\.(?P<outpost>op-[0-9a-f]{17})\.s3-outposts\.(?P<region>[a-z0-9\-]+)\.amazonaws\.com(?:\.cn)?$`)
+	// <access-point-name>-<account-id>.s3-accesspoint[-fips][.dualstack].<region>.amazonaws.com
+	s3AccessPointHostRegexp = regexache.MustCompile(`^(?P<name>[a-z0-9][\-a-z0-9]*)-(?P<account>\d{12})\.s3-accesspoint(?:-fips)?(?:\.dualstack)?\.(?P<region>[a-z0-9\-]+)\.amazonaws\.com(?:\.cn)?$`)
+	// [<bucket>.]s3[-fips][.dualstack][.<region>].amazonaws.com or [<bucket>.]s3-<region>.amazonaws.com
+	s3HostRegexp = regexache.MustCompile(`^(?:(?P<bucket>.+)\.)?s3(?:-fips)?(?:\.dualstack)?(?:[\.\-](?P<region>[a-z]{2}(?:-[a-z]+)+-\d+))?\.amazonaws\.com(?:\.cn)?$`)
+	// <bucket>.s3-accelerate[.dualstack].amazonaws.com
+	s3AccelerateHostRegexp = regexache.MustCompile(`^(?P<bucket>.+)\.s3-accelerate(?:\.dualstack)?\.amazonaws\.com$`)
+)
+
+// s3URI is the parsed form of an S3 URI or URL.
+type s3URI struct {
+	accessPointARN string
+	bucket         string
+	key            string
+	region         string
+}
+
+// parseS3URI parses an s3:// URI or an S3 virtual-hosted-style, path-style,
+// access point, Outposts access point or directory bucket URL.
+func parseS3URI(s string) (*s3URI, error) {
+	if rest, ok := strings.CutPrefix(s, s3URIScheme); ok {
+		return parseS3SchemeURI(rest)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported scheme %q, expected s3, https or http", u.Scheme)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	key := strings.TrimPrefix(u.Path, "/")
+
+	if m := findNamedSubmatches(s3DirectoryBucketHostRegexp, host); m != nil {
+		return &s3URI{
+			bucket: m["bucket"],
+			key:    key,
+			region: m["region"],
+		}, nil
+	}
+
+	if m := findNamedSubmatches(s3OutpostsAccessPointHostRegexp, host); m != nil {
+		return &s3URI{
+			accessPointARN: arn.ARN{
+				Partition: names.PartitionForRegion(m["region"]).ID(),
+				Service:   "s3-outposts",
+				Region:    m["region"],
+				AccountID: m["account"],
+				Resource:  "outpost/" + m["outpost"] + "/accesspoint/" + m["name"],
+			}.String(),
+			key:    key,
+			region: m["region"],
+		}, nil
+	}
+
+	if m := findNamedSubmatches(s3AccessPointHostRegexp, host); m != nil {
+		return &s3URI{
+			accessPointARN: arn.ARN{
+				Partition: names.PartitionForRegion(m["region"]).ID(),
+				Service:   "s3",
+				Region:    m["region"],
+				AccountID: m["account"],
+				Resource:  "accesspoint/" + m["name"],
+			}.String(),
+			key:    key,
+			region: m["region"],
+		}, nil
+	}
+
+	if m := findNamedSubmatches(s3AccelerateHostRegexp, host); m != nil {
+		return newS3BucketURI(m["bucket"], key, "")
+	}
+
+	if m := findNamedSubmatches(s3HostRegexp, host); m != nil {
+		region := m["region"]
+
+		// Virtual-hosted-style.
+		// Buckets addressed through the legacy global endpoint may be in any Region.
+		if bucket := m["bucket"]; bucket != "" {
+			return newS3BucketURI(bucket, key, region)
+		}
+
+		// Path-style.
+		// Requests to the legacy global endpoint are served from us-east-1.
+		if region == "" && !strings.HasSuffix(host, ".cn") {
+			region = endpoints.UsEast1RegionID
+		}
+		bucket, key, _ := strings.Cut(key, "/")
+		return newS3BucketURI(bucket, key, region)
+	}
+
+	return nil, fmt.Errorf("unsupported S3 endpoint %q", host)
+}
+
+func parseS3SchemeURI(s string) (*s3URI, error) {
+	// s3://arn:<partition>:s3:<region>:<account-id>:accesspoint/<name>[/<key>]
+	if arn.IsARN(s) {
+		parsed, err := arn.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+
+		resource, key, err := splitS3AccessPointARNResource(parsed)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Resource = resource
+
+		return &s3URI{
+			accessPointARN: parsed.String(),
+			key:            key,
+			region:         parsed.Region,
+		}, nil
+	}
+
+	bucket, key, _ := strings.Cut(s, "/")
+	return newS3BucketURI(bucket, key, "")
+}
+
+// splitS3AccessPointARNResource splits the resource of an access point or Outposts access point ARN
+// into the access point resource and any trailing object key.
+func splitS3AccessPointARNResource(parsed arn.ARN) (string, string, error) {
+	switch parsed.Service {
+	case "s3":
+		resource, ok := strings.CutPrefix(parsed.Resource, "accesspoint/")
+		if !ok {
+			return "", "", fmt.Errorf("unsupported ARN resource %q, expected an access point", parsed.Resource)
+		}
+		name, key, _ := strings.Cut(resource, "/")
+		return "accesspoint/" + name, key, nil
+	case "s3-outposts":
+		parts := strings.SplitN(parsed.Resource, "/", 5)
+		if len(parts) < 4 || parts[0] != "outpost" || parts[2] != "accesspoint" {
+			return "", "", fmt.Errorf("unsupported ARN resource %q, expected an Outposts access point", parsed.Resource)
+		}
+		var key string
+		if len(parts) == 5 {
+			key = parts[4]
+		}
+		return strings.Join(parts[:4], "/"), key, nil
+	default:
+		return "", "", fmt.Errorf("unsupported ARN service %q", parsed.Service)
+	}
+}
+
+func newS3BucketURI(bucket, key, region string) (*s3URI, error) {
+	if !s3BucketNameRegexp.MatchString(bucket) {
+		return nil, fmt.Errorf("invalid bucket name %q", bucket)
+	}
+
+	return &s3URI{
+		bucket: bucket,
+		key:    key,
+		region: region,
+	}, nil
+}
+
+// buildS3URI returns an s3:// URI for a bucket name, bucket ARN, access point alias or
+// access point ARN and an optional object key.
+func buildS3URI(bucket, key string) (string, error) {
+	if arn.IsARN(bucket) {
+		parsed, err := arn.Parse(bucket)
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case parsed.Service == "s3" && parsed.Region == "" && parsed.AccountID == "":
+			// arn:<partition>:s3:::<bucket>
+			bucket = parsed.Resource
+		default:
+			_, suffix, err := splitS3AccessPointARNResource(parsed)
+			if err != nil {
+				return "", err
+			}
+			if suffix != "" {
+				return "", fmt.Errorf("unsupported ARN resource %q, expected an access point", parsed.Resource)
+			}
+		}
+	}
+
+	if !arn.IsARN(bucket) && !s3BucketNameRegexp.MatchString(bucket) {
+		return "", fmt.Errorf("invalid bucket name %q", bucket)
+	}
+
+	uri := s3URIScheme + bucket
+	if key = strings.TrimPrefix(key, "/"); key != "" {
+		uri += "/" + key
+	}
+
+	return uri, nil
+}
+
+func findNamedSubmatches(re *regexp.Regexp, s string) map[string]string {
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			result[name] = match[i]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an s3:// URI from a bucket and an object key",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name, bucket ARN, access point alias or access point ARN",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key or key prefix. May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, key string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildS3URI(bucket, key)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
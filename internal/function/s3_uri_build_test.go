@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIBuildFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("amzn-s3-demo-bucket", "/path/to/object.csv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://amzn-s3-demo-bucket/path/to/object.csv"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_emptyKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("amzn-s3-demo-bucket", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://amzn-s3-demo-bucket"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_bucketARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("arn:aws:s3:::amzn-s3-demo-bucket", "object.csv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://amzn-s3-demo-bucket/object.csv"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_accessPointARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig("arn:aws:s3:us-west-2:444455556666:accesspoint/example", "object.csv"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "s3://arn:aws:s3:us-west-2:444455556666:accesspoint/example/object.csv"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalidARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("arn:aws:iam::444455556666:role/example", "object.csv"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*ARN[\s\n]*service`),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig("Invalid_Bucket", "object.csv"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*bucket[\s\n]*name`),
+			},
+		},
+	})
+}
+
+func testS3URIBuildFunctionConfig(bucket, key string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::s3_uri_build(%[1]q, %[2]q)
+}
+`, bucket, key)
+}
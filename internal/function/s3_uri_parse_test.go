@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	// A region of "null" indicates that the Region cannot be determined.
+	testCases := map[string]struct {
+		uri            string
+		bucket         string
+		key            string
+		region         string
+		accessPointARN string
+	}{
+		"s3 scheme": {
+			uri:    "s3://amzn-s3-demo-bucket/path/to/object.csv",
+			bucket: "amzn-s3-demo-bucket",
+			key:    "path/to/object.csv",
+			region: "null",
+		},
+		"s3 scheme access point ARN": {
+			uri:            "s3://arn:aws:s3:us-west-2:444455556666:accesspoint/example/path/to/object.csv",
+			key:            "path/to/object.csv",
+			region:         "us-west-2",
+			accessPointARN: "arn:aws:s3:us-west-2:444455556666:accesspoint/example",
+		},
+		"virtual-hosted-style": {
+			uri:    "https://amzn-s3-demo-bucket.s3.us-west-2.amazonaws.com/path/to/object.csv",
+			bucket: "amzn-s3-demo-bucket",
+			key:    "path/to/object.csv",
+			region: "us-west-2",
+		},
+		"virtual-hosted-style global endpoint": {
+			uri:    "https://amzn-s3-demo-bucket.s3.amazonaws.com/object.csv",
+			bucket: "amzn-s3-demo-bucket",
+			key:    "object.csv",
+			region: "null",
+		},
+		"path-style": {
+			uri:    "https://s3.eu-west-1.amazonaws.com/amzn-s3-demo-bucket/path/to/object.csv",
+			bucket: "amzn-s3-demo-bucket",
+			key:    "path/to/object.csv",
+			region: "eu-west-1",
+		},
+		"path-style global endpoint": {
+			uri:    "https://s3.amazonaws.com/amzn-s3-demo-bucket/object.csv",
+			bucket: "amzn-s3-demo-bucket",
+			key:    "object.csv",
+			region: "us-east-1",
+		},
+		"access point": {
+			uri:            "https://example-444455556666.s3-accesspoint.us-west-2.amazonaws.com/object.csv",
+			key:            "object.csv",
+			region:         "us-west-2",
+			accessPointARN: "arn:aws:s3:us-west-2:444455556666:accesspoint/example",
+		},
+		"Outposts access point": {
+			uri:            "https://example-444455556666.op-01ac5d28a6a232904.s3-outposts.us-west-2.amazonaws.com/object.csv",
+			key:            "object.csv",
+			region:         "us-west-2",
+			accessPointARN: "arn:aws:s3-outposts:us-west-2:444455556666:outpost/op-01ac5d28a6a232904/accesspoint/example",
+		},
+		"directory bucket": {
+			uri:    "https://amzn-s3-demo-bucket--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.csv",
+			bucket: "amzn-s3-demo-bucket--usw2-az1--x-s3",
+			key:    "object.csv",
+			region: "us-west-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testS3URIParseFunctionConfig(testCase.uri),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("access_point_arn", testCase.accessPointARN),
+							resource.TestCheckOutput("bucket", testCase.bucket),
+							resource.TestCheckOutput("key", testCase.key),
+							resource.TestCheckOutput("region", testCase.region),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.com/object.csv"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*S3[\s\n]*endpoint`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "access_point_arn" {
+  value = local.result.access_point_arn
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "key" {
+  value = local.result.key
+}
+
+output "region" {
+  value = local.result.region == null ? "null" : local.result.region
+}
+`, arg)
+}
@@ -26,11 +26,11 @@ func ParseArgs(s string) Args {
 		if key == "" {
 			continue
 		}
-		key, value, _ := strings.Cut(key, "=")
+		key, value, found := strings.Cut(key, "=")
 		// Unquote.
 		key = strings.Trim(key, `"`)
 		value = strings.Trim(value, `"`)
-		if value == "" {
+		if !found {
 			args.Positional = append(args.Positional, key)
 		} else {
 			args.Keyword[key] = value
@@ -72,6 +72,23 @@ func TestArgsSingleKeyword(t *testing.T) {
 	}
 }
 
+func TestArgsEmptyKeyword(t *testing.T) {
+	t.Parallel()
+
+	input := `vv=""`
+	args := ParseArgs(input)
+
+	if got, want := len(args.Positional), 0; got != want {
+		t.Errorf("length of Positional = %v, want %v", got, want)
+	}
+	if got, want := len(args.Keyword), 1; got != want {
+		t.Errorf("length of Keyword = %v, want %v", got, want)
+	}
+	if got, ok := args.Keyword["vv"]; !ok || got != "" {
+		t.Errorf("Keyword[vv] = %v, want empty string", got)
+	}
+}
+
 func TestArgsMultipleKeywords(t *testing.T) {
 	t.Parallel()
 
@@ -43,6 +43,7 @@ type ResourceIdentity struct {
 	isSingletonIdentity            bool
 	identityAttributeName          string
 	IdentityDuplicateAttrNames     []string
+	noIdentityDuplicateAttrs       bool
 	IdentityAttributes             []IdentityAttribute
 	MutableIdentity                bool
 	IdentityVersion                int64
@@ -98,6 +99,10 @@ func (r ResourceIdentity) HasIdentityDuplicateAttrs() bool {
 	return len(r.IdentityDuplicateAttrNames) > 0
 }
 
+func (r ResourceIdentity) HasNoIdentityDuplicateAttrs() bool {
+	return r.noIdentityDuplicateAttrs
+}
+
 func (r ResourceIdentity) IdentityDuplicateAttrs() []string {
 	return tfslices.ApplyToAll(r.IdentityDuplicateAttrNames, func(s string) string {
 		return namesgen.ConstOrQuote(s)
@@ -289,6 +294,12 @@ func ParseBoolAttr(name, value string) (bool, error) {
 func parseIdentityDuplicateAttrNames(args Args, implementation Implementation, d *ResourceIdentity) {
 	var attrs []string
 	if attr, ok := args.Keyword["identityDuplicateAttributes"]; ok {
+		// An empty value means that no attribute, not even `id`, duplicates the identity.
+		if attr == "" {
+			d.noIdentityDuplicateAttrs = true
+			d.IdentityDuplicateAttrNames = nil
+			return
+		}
 		attrs = strings.Split(attr, ";")
 	}
 	if implementation == ImplementationSDK {
@@ -774,10 +774,8 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.arnAttribute = d.IdentityAttributeName()
 			}
 			if d.HasInherentRegionIdentity() {
-				if d.Implementation == common.ImplementationFramework {
-					if !slices.Contains(d.IdentityDuplicateAttrNames, "id") {
-						d.SetImportStateIDAttribute(d.IdentityAttributeName())
-					}
+				if !slices.Contains(d.IdentityDuplicateAttrNames, "id") {
+					d.SetImportStateIDAttribute(d.IdentityAttributeName())
 				}
 			}
 			if d.IsSingletonIdentity() {
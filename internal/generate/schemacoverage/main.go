@@ -0,0 +1,482 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build ignore
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+var (
+	servicePackageRoot = flag.String("ServicePackageRoot", "internal/service", "path to service package root directory")
+)
+
+// Operation name patterns, in order of preference, for each kind of operation.
+// Each pattern is formatted with a CamelCase variant of the resource's registered name.
+var operationPatterns = map[string][]string{
+	"create":   {"Create%s", "Put%s", "Register%s"},
+	"describe": {"Describe%s", "Get%s", "Describe%sAttributes", "Get%sAttributes"},
+	"update":   {"Update%s", "Modify%s", "Update%sConfiguration", "Modify%sAttribute", "Set%sAttributes", "Put%s"},
+}
+
+// ignoredFieldNames are the names of API fields that are not expected to be exposed by any resource.
+var ignoredFieldNames = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"DryRun",
+	"Filters",
+	"Marker",
+	"MaxItems",
+	"MaxRecords",
+	"MaxResults",
+	"NextMarker",
+	"NextToken",
+	"ResultMetadata",
+}
+
+const (
+	maxFieldDepth = 5
+)
+
+type Report struct {
+	Resources []ResourceReport `json:"resources"`
+}
+
+type ResourceReport struct {
+	Service         string           `json:"service"`
+	TypeName        string           `json:"type_name"`
+	Name            string           `json:"name"`
+	Operations      []string         `json:"operations"`
+	UnexposedFields []UnexposedField `json:"unexposed_fields"`
+}
+
+type UnexposedField struct {
+	Operation string `json:"operation"`
+	Shape     string `json:"shape"` // "input" or "output".
+	Path      string `json:"path"`
+}
+
+type registration struct {
+	TypeName string
+	Name     string
+}
+
+func main() {
+	filename := `schema_coverage.json`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", filename)
+
+	ctx := context.Background()
+
+	schemas, err := resourceSchemas(ctx)
+
+	if err != nil {
+		g.Fatalf("error reading provider schema: %s", err)
+	}
+
+	data, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	awsClientType := reflect.TypeFor[*conns.AWSClient]()
+	var report Report
+
+	for _, l := range data {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+
+		spdFile := fmt.Sprintf("%s/%s/service_package_gen.go", *servicePackageRoot, p)
+
+		if _, err := os.Stat(spdFile); err != nil {
+			continue
+		}
+
+		registrations, err := resourceRegistrations(spdFile)
+
+		if err != nil {
+			g.Fatalf("error parsing %s: %s", spdFile, err)
+		}
+
+		if len(registrations) == 0 {
+			continue
+		}
+
+		method, ok := awsClientType.MethodByName(l.ProviderNameUpper() + "Client")
+
+		if !ok {
+			g.Warnf("No AWS SDK for Go v2 client for service %s", p)
+			continue
+		}
+
+		clientType := method.Type.Out(0)
+
+		// API fields are matched against the attributes of all the service's resources.
+		exposed := make(map[string]bool)
+		for _, r := range registrations {
+			if s, ok := schemas[r.TypeName]; ok {
+				addAttributeNames(exposed, s.Block)
+			}
+		}
+
+		for _, r := range registrations {
+			if _, ok := schemas[r.TypeName]; !ok {
+				continue
+			}
+
+			report.Resources = append(report.Resources, resourceReport(p, r, clientType, exposed))
+		}
+	}
+
+	slices.SortStableFunc(report.Resources, func(a, b ResourceReport) int {
+		return cmp.Or(cmp.Compare(a.Service, b.Service), cmp.Compare(a.TypeName, b.TypeName))
+	})
+
+	body, err := json.MarshalIndent(report, "", "  ")
+
+	if err != nil {
+		g.Fatalf("error marshaling report: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("error generating schema coverage report: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// resourceSchemas returns the schemas of all resources served by the provider, keyed by type name.
+func resourceSchemas(ctx context.Context) (map[string]*tfprotov5.Schema, error) {
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, diag := range response.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	return response.ResourceSchemas, nil
+}
+
+// resourceRegistrations returns the Plugin SDK v2 and Plugin Framework resources registered in the specified
+// service_package_gen.go file.
+func resourceRegistrations(filename string) ([]registration, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var registrations []registration
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+
+		if !ok || funcDecl.Recv == nil || funcDecl.Body == nil {
+			continue
+		}
+
+		if name := funcDecl.Name.Name; name != "FrameworkResources" && name != "SDKResources" {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+
+			if !ok {
+				return true
+			}
+
+			var r registration
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				value, ok := kv.Value.(*ast.BasicLit)
+				if !ok || value.Kind != token.STRING {
+					continue
+				}
+
+				s, err := strconv.Unquote(value.Value)
+				if err != nil {
+					continue
+				}
+
+				switch key.Name {
+				case "TypeName":
+					r.TypeName = s
+				case "Name":
+					r.Name = s
+				}
+			}
+
+			if r.TypeName != "" {
+				registrations = append(registrations, r)
+				return false
+			}
+
+			return true
+		})
+	}
+
+	return registrations, nil
+}
+
+// addAttributeNames adds the normalized names of all attributes and nested blocks in the specified schema block.
+func addAttributeNames(names map[string]bool, block *tfprotov5.SchemaBlock) {
+	if block == nil {
+		return
+	}
+
+	for _, attribute := range block.Attributes {
+		for _, v := range nameVariants(attribute.Name) {
+			names[v] = true
+		}
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		for _, v := range nameVariants(nestedBlock.TypeName) {
+			names[v] = true
+		}
+		addAttributeNames(names, nestedBlock.Block)
+	}
+}
+
+func resourceReport(servicePackage string, r registration, clientType reflect.Type, exposed map[string]bool) ResourceReport {
+	report := ResourceReport{
+		Service:         servicePackage,
+		TypeName:        r.TypeName,
+		Name:            r.Name,
+		Operations:      []string{},
+		UnexposedFields: []UnexposedField{},
+	}
+
+	resourceName := strings.NewReplacer(" ", "", "-", "", "_", "").Replace(r.Name)
+	resourceNameVariants := nameVariants(resourceName)
+
+	methods := make(map[string]reflect.Method)
+	for i := range clientType.NumMethod() {
+		method := clientType.Method(i)
+		methods[strings.ToLower(method.Name)] = method
+	}
+
+	for _, kind := range []string{"create", "describe", "update"} {
+		method, ok := findOperation(methods, operationPatterns[kind], resourceName)
+
+		if !ok || slices.Contains(report.Operations, method.Name) {
+			continue
+		}
+
+		report.Operations = append(report.Operations, method.Name)
+
+		// func(*Client, context.Context, *<Op>Input, ...func(*Options)) (*<Op>Output, error).
+		if method.Type.NumIn() > 2 {
+			w := fieldWalker{
+				exposed:              exposed,
+				resourceNameVariants: resourceNameVariants,
+			}
+			w.walk(method.Type.In(2), "", 0)
+
+			for _, path := range w.unexposed {
+				report.UnexposedFields = append(report.UnexposedFields, UnexposedField{Operation: method.Name, Shape: "input", Path: path})
+			}
+		}
+
+		if method.Type.NumOut() > 0 {
+			w := fieldWalker{
+				exposed:              exposed,
+				isOutput:             true,
+				resourceNameVariants: resourceNameVariants,
+			}
+			w.walk(method.Type.Out(0), "", 0)
+
+			for _, path := range w.unexposed {
+				report.UnexposedFields = append(report.UnexposedFields, UnexposedField{Operation: method.Name, Shape: "output", Path: path})
+			}
+		}
+	}
+
+	return report
+}
+
+func findOperation(methods map[string]reflect.Method, patterns []string, resourceName string) (reflect.Method, bool) {
+	for _, pattern := range patterns {
+		for _, name := range []string{resourceName, plural(resourceName)} {
+			if method, ok := methods[strings.ToLower(fmt.Sprintf(pattern, name))]; ok {
+				return method, true
+			}
+		}
+	}
+
+	return reflect.Method{}, false
+}
+
+// fieldWalker walks an operation's input or output shape recording the paths of fields not exposed by any resource.
+type fieldWalker struct {
+	exposed              map[string]bool
+	isOutput             bool
+	resourceNameVariants []string
+	unexposed            []string
+	visiting             []reflect.Type
+}
+
+func (w *fieldWalker) walk(typ reflect.Type, path string, depth int) {
+	typ = shapeType(typ)
+
+	if typ == nil || depth > maxFieldDepth || slices.Contains(w.visiting, typ) {
+		return
+	}
+
+	w.visiting = append(w.visiting, typ)
+	defer func() { w.visiting = w.visiting[:len(w.visiting)-1] }()
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		if !field.IsExported() || slices.Contains(ignoredFieldNames, field.Name) {
+			continue
+		}
+
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		// Descend through any output field wrapping the resource, e.g. DescribeClusterOutput.Cluster.
+		if w.isOutput && depth == 0 && w.isResourceWrapper(field) {
+			w.walk(field.Type, fieldPath, depth+1)
+			continue
+		}
+
+		if !w.isExposed(field.Name) {
+			w.unexposed = append(w.unexposed, fieldPath)
+			continue
+		}
+
+		w.walk(field.Type, fieldPath, depth+1)
+	}
+}
+
+func (w *fieldWalker) isExposed(fieldName string) bool {
+	for _, v := range nameVariants(fieldName) {
+		if w.exposed[v] {
+			return true
+		}
+
+		// e.g. QueueName -> name.
+		for _, prefix := range w.resourceNameVariants {
+			if s, ok := strings.CutPrefix(v, prefix); ok && w.exposed[s] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (w *fieldWalker) isResourceWrapper(field reflect.StructField) bool {
+	if slices.Contains(w.resourceNameVariants, normalize(field.Name)) {
+		return true
+	}
+
+	if typ := shapeType(field.Type); typ != nil && slices.Contains(w.resourceNameVariants, normalize(typ.Name())) {
+		return true
+	}
+
+	return false
+}
+
+// shapeType returns the AWS SDK for Go v2 structure type underlying the specified type, or nil.
+func shapeType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ == reflect.TypeFor[time.Time]() || !strings.HasPrefix(typ.PkgPath(), "github.com/aws/aws-sdk-go-v2/service/") {
+		return nil
+	}
+
+	return typ
+}
+
+// normalize returns the lowercase form of the specified name with any underscores removed.
+func normalize(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// nameVariants returns the normalized singular and plural forms of the specified name.
+func nameVariants(s string) []string {
+	s = normalize(s)
+	variants := []string{s, plural(s)}
+
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		variants = append(variants, strings.TrimSuffix(s, "ies")+"y")
+	case strings.HasSuffix(s, "s"):
+		variants = append(variants, strings.TrimSuffix(s, "s"))
+	}
+
+	return variants
+}
+
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ey"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	case strings.HasSuffix(s, "s"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
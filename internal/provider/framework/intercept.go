@@ -13,11 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type awsClient interface {
@@ -383,6 +387,11 @@ type innerFunc[Request, Response any] func(ctx context.Context, request Request,
 // interceptedHandler returns a handler that runs any interceptors.
 func interceptedHandler[Request interceptedRequest, Response interceptedResponse](interceptors []interceptorFunc[Request, Response], f innerFunc[Request, Response], hasError hasErrorFn[Response], c awsClient) func(context.Context, Request, *Response) {
 	return func(ctx context.Context, request Request, response *Response) {
+		if v := operationName(request); v != "" {
+			ctx = conns.NewResourceOperationContext(ctx, v)
+		}
+		ctx = conns.NewResourceIDContext(ctx, resourceID(ctx, request))
+
 		opts := interceptorOptions[Request, Response]{
 			c:        c,
 			request:  &request,
@@ -421,6 +430,65 @@ func interceptedHandler[Request interceptedRequest, Response interceptedResponse
 
 type hasErrorFn[Response interceptedResponse] func(response *Response) bool
 
+// operationName returns the name of the operation handling the specified request, e.g. "Create".
+func operationName(request any) string {
+	switch request.(type) {
+	case resource.CreateRequest:
+		return "Create"
+	case datasource.ReadRequest, resource.ReadRequest:
+		return "Read"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	case resource.ModifyPlanRequest:
+		return "ModifyPlan"
+	case resource.ImportStateRequest:
+		return "Import"
+	case ephemeral.OpenRequest:
+		return "Open"
+	case ephemeral.RenewRequest:
+		return "Renew"
+	case ephemeral.CloseRequest:
+		return "Close"
+	case action.InvokeRequest:
+		return "Invoke"
+	default:
+		return ""
+	}
+}
+
+// resourceID returns the ID of the resource being handled, if known.
+func resourceID(ctx context.Context, request any) string {
+	var state tfsdk.State
+	switch request := request.(type) {
+	case resource.ReadRequest:
+		state = request.State
+	case resource.UpdateRequest:
+		state = request.State
+	case resource.DeleteRequest:
+		state = request.State
+	case resource.ModifyPlanRequest:
+		state = request.State
+	case resource.ImportStateRequest:
+		return request.ID
+	default:
+		return ""
+	}
+
+	if state.Raw.IsNull() {
+		return ""
+	}
+
+	// Not all resources have an "id" attribute.
+	var id types.String
+	if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
+
 func dataSourceSchemaHasError(response *datasource.SchemaResponse) bool {
 	return response.Diagnostics.HasError()
 }
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// arnSourceFunc returns the ARNs of resources to be listed.
+type arnSourceFunc func(context.Context) iter.Seq2[string, error]
+
+// expandListARNSource returns the source of resource ARNs specified by any top-level "tag_filter" or
+// "resource_explorer" blocks in a List request's configuration, along with a copy of the request with the
+// blocks removed. This allows a list resource's own List implementation to be unaware of the blocks.
+// A nil source is returned if neither block is specified.
+// The blocks cannot be combined with any of the list resource's own arguments other than "region".
+func expandListARNSource(ctx context.Context, c *conns.AWSClient, resourceTypes []string, request list.ListRequest) (arnSourceFunc, list.ListRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if request.Config.Raw.IsNull() || !request.Config.Raw.IsKnown() {
+		return nil, request, diags
+	}
+
+	if schema, ok := request.Config.Schema.(listschema.Schema); !ok {
+		diags.AddError("Unexpected List Configuration Schema Type", fmt.Sprintf("Expected list/schema.Schema, got %T", request.Config.Schema))
+		return nil, request, diags
+	} else if _, ok := schema.Blocks[listResourceTagFilterBlockName]; !ok {
+		// The blocks were not injected.
+		return nil, request, diags
+	}
+
+	tagFilters, d := expandListTagFilters(ctx, request.Config)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, request, diags
+	}
+
+	resourceExplorer, d := expandListResourceExplorer(ctx, request.Config)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, request, diags
+	}
+
+	request, d = removeListConfigBlocks(ctx, request, listResourceTagFilterBlockName, listResourceResourceExplorerBlockName)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, request, diags
+	}
+
+	switch {
+	case len(tagFilters) > 0 && resourceExplorer != nil:
+		diags.AddAttributeError(
+			path.Root(listResourceResourceExplorerBlockName),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%q cannot be specified when %q is specified", listResourceResourceExplorerBlockName, listResourceTagFilterBlockName),
+		)
+		return nil, request, diags
+
+	case len(tagFilters) > 0:
+		diags.Append(validateListARNSourceConfig(request.Config, listResourceTagFilterBlockName)...)
+		if diags.HasError() {
+			return nil, request, diags
+		}
+
+		return taggedResourceARNs(c, resourceTypes, tagFilters), request, diags
+
+	case resourceExplorer != nil:
+		diags.Append(validateListARNSourceConfig(request.Config, listResourceResourceExplorerBlockName)...)
+		if diags.HasError() {
+			return nil, request, diags
+		}
+
+		return resourceExplorerResourceARNs(c, resourceTypes, resourceExplorer), request, diags
+	}
+
+	return nil, request, diags
+}
+
+// validateListARNSourceConfig returns an error for each value in a List request's configuration, other than "region",
+// that is specified along with the named block.
+// Listing by ARN bypasses the list resource's own List implementation, so its arguments would otherwise be silently ignored.
+func validateListARNSourceConfig(config tfsdk.Config, blockName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var values map[string]tftypes.Value
+	if err := config.Raw.As(&values); err != nil {
+		diags.AddError("Reading List Configuration", err.Error())
+		return diags
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if name == names.AttrRegion || !isListConfigValueSpecified(values[name]) {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%q cannot be specified when %q is specified", name, blockName),
+		)
+	}
+
+	return diags
+}
+
+// isListConfigValueSpecified returns whether a List configuration value is specified.
+// Unspecified nested blocks are represented by empty lists or sets.
+func isListConfigValueSpecified(v tftypes.Value) bool {
+	if !v.IsKnown() {
+		return true
+	}
+	if v.IsNull() {
+		return false
+	}
+
+	if typ := v.Type(); typ.Is(tftypes.List{}) || typ.Is(tftypes.Set{}) {
+		var elems []tftypes.Value
+		if err := v.As(&elems); err == nil {
+			return len(elems) > 0
+		}
+	}
+
+	return true
+}
+
+// listSchemaHasRequiredAttributes returns whether a List schema has any required attributes.
+// Such list resources always need their own arguments and so cannot be listed by ARN.
+func listSchemaHasRequiredAttributes(schema listschema.Schema) bool {
+	for _, v := range schema.Attributes {
+		if v.IsRequired() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeListConfigBlocks returns a copy of a List request with the specified top-level blocks removed from its configuration.
+func removeListConfigBlocks(ctx context.Context, request list.ListRequest, blockNames ...string) (list.ListRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := request.Config
+
+	schema, ok := config.Schema.(listschema.Schema)
+	if !ok {
+		diags.AddError("Unexpected List Configuration Schema Type", fmt.Sprintf("Expected list/schema.Schema, got %T", config.Schema))
+		return request, diags
+	}
+
+	var values map[string]tftypes.Value
+	if err := config.Raw.As(&values); err != nil {
+		diags.AddError("Reading List Configuration", err.Error())
+		return request, diags
+	}
+
+	schema.Blocks = maps.Clone(schema.Blocks)
+	for _, blockName := range blockNames {
+		delete(schema.Blocks, blockName)
+		delete(values, blockName)
+	}
+
+	request.Config = tfsdk.Config{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), values),
+		Schema: schema,
+	}
+
+	return request, diags
+}
+
+// listByARN returns a List implementation which reads each resource whose ARN is returned by the specified source.
+func listByARN(lister framework.ARNLister, c *conns.AWSClient, arns arnSourceFunc) func(context.Context, list.ListRequest, *list.ListResultsStream) {
+	return func(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+		tflog.Info(ctx, "Listing resources by ARN")
+
+		stream.Results = func(yield func(list.ListResult) bool) {
+			for arn, err := range arns(ctx) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				result := request.NewListResult(ctx)
+
+				if !lister.ListResultFromARN(ctx, c, arn, request.IncludeResource, &result) {
+					continue
+				}
+
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
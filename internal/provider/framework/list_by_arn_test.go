@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandListARNSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrName: listschema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrRegion: listresourceattribute.Region(),
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listschema.ListNestedBlock{
+				NestedObject: listschema.NestedBlockObject{
+					Attributes: map[string]listschema.Attribute{
+						names.AttrName: listschema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			listResourceTagFilterBlockName:        listresourceattribute.TagFilter(),
+			listResourceResourceExplorerBlockName: listresourceattribute.ResourceExplorer(),
+		},
+	}
+	tagFilterType := s.Blocks[listResourceTagFilterBlockName].Type().TerraformType(ctx).(tftypes.List)
+	tagFilterElemType := tagFilterType.ElementType.(tftypes.Object)
+	resourceExplorerType := s.Blocks[listResourceResourceExplorerBlockName].Type().TerraformType(ctx)
+	filterType := s.Blocks[names.AttrFilter].Type().TerraformType(ctx)
+
+	tagFilter := tftypes.NewValue(tagFilterElemType, map[string]tftypes.Value{
+		names.AttrKey:    tftypes.NewValue(tftypes.String, "team"),
+		names.AttrValues: tftypes.NewValue(tagFilterElemType.AttributeTypes[names.AttrValues], nil),
+	})
+	resourceExplorer := tftypes.NewValue(resourceExplorerType, map[string]tftypes.Value{
+		"query_string": tftypes.NewValue(tftypes.String, "tag:team=payments"),
+		"view_arn":     tftypes.NewValue(tftypes.String, nil),
+	})
+
+	configFromBlocks := func(tagFilters []tftypes.Value, resourceExplorer tftypes.Value, name ...string) tfsdk.Config {
+		nameValue := tftypes.NewValue(tftypes.String, nil)
+		if len(name) > 0 {
+			nameValue = tftypes.NewValue(tftypes.String, name[0])
+		}
+
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				names.AttrFilter:                      tftypes.NewValue(filterType, []tftypes.Value{}),
+				names.AttrName:                        nameValue,
+				names.AttrRegion:                      tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+				listResourceTagFilterBlockName:        tftypes.NewValue(tagFilterType, tagFilters),
+				listResourceResourceExplorerBlockName: resourceExplorer,
+			}),
+			Schema: s,
+		}
+	}
+
+	tests := map[string]struct {
+		config     tfsdk.Config
+		wantSource bool
+		wantErr    bool
+	}{
+		"neither": {
+			config: configFromBlocks(nil, tftypes.NewValue(resourceExplorerType, nil)),
+		},
+		"tag filter": {
+			config:     configFromBlocks([]tftypes.Value{tagFilter}, tftypes.NewValue(resourceExplorerType, nil)),
+			wantSource: true,
+		},
+		"resource explorer": {
+			config:     configFromBlocks(nil, resourceExplorer),
+			wantSource: true,
+		},
+		"both": {
+			config:  configFromBlocks([]tftypes.Value{tagFilter}, resourceExplorer),
+			wantErr: true,
+		},
+		"own argument": {
+			config: configFromBlocks(nil, tftypes.NewValue(resourceExplorerType, nil), "example"),
+		},
+		"tag filter with own argument": {
+			config:  configFromBlocks([]tftypes.Value{tagFilter}, tftypes.NewValue(resourceExplorerType, nil), "example"),
+			wantErr: true,
+		},
+		"resource explorer with own argument": {
+			config:  configFromBlocks(nil, resourceExplorer, "example"),
+			wantErr: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			arns, request, diags := expandListARNSource(ctx, nil, []string{"ecs:cluster"}, list.ListRequest{Config: tc.config})
+			if got, want := diags.HasError(), tc.wantErr; got != want {
+				t.Fatalf("expected error %t, got diags: %s", want, diags)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			if got, want := arns != nil, tc.wantSource; got != want {
+				t.Errorf("expected ARN source %t, got %t", want, got)
+			}
+
+			var values map[string]tftypes.Value
+			if err := request.Config.Raw.As(&values); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for _, name := range []string{listResourceTagFilterBlockName, listResourceResourceExplorerBlockName} {
+				if _, ok := values[name]; ok {
+					t.Errorf("expected %q to be removed from configuration", name)
+				}
+			}
+		})
+	}
+}
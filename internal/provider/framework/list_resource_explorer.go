@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+)
+
+const (
+	listResourceResourceExplorerBlockName = "resource_explorer"
+)
+
+type listResourceInjectResourceExplorerBlockInterceptor struct{}
+
+func (r listResourceInjectResourceExplorerBlockInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if listSchemaHasRequiredAttributes(response.Schema) {
+			return
+		}
+
+		if response.Schema.Blocks == nil {
+			response.Schema.Blocks = make(map[string]listschema.Block)
+		}
+		if _, ok := response.Schema.Blocks[listResourceResourceExplorerBlockName]; !ok {
+			// Inject a top-level "resource_explorer" block.
+			response.Schema.Blocks[listResourceResourceExplorerBlockName] = listresourceattribute.ResourceExplorer()
+		}
+	}
+}
+
+// listResourceInjectResourceExplorerBlock injects a "resource_explorer" block into a resource's List schema.
+func listResourceInjectResourceExplorerBlock() listResourceSchemaInterceptor {
+	return &listResourceInjectResourceExplorerBlockInterceptor{}
+}
+
+type listResourceExplorerModel struct {
+	QueryString types.String `tfsdk:"query_string"`
+	ViewARN     types.String `tfsdk:"view_arn"`
+}
+
+// expandListResourceExplorer returns the top-level "resource_explorer" block in a List request's configuration.
+// A nil value is returned if the block is not specified.
+func expandListResourceExplorer(ctx context.Context, config tfsdk.Config) (*listResourceExplorerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var resourceExplorer *listResourceExplorerModel
+	diags.Append(config.GetAttribute(ctx, path.Root(listResourceResourceExplorerBlockName), &resourceExplorer)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return resourceExplorer, diags
+}
+
+// resourceExplorerResourceARNs returns the ARNs of resources of the specified types matching a Resource Explorer search.
+// Only resources in the caller's account and Region are returned, as these are the only resources which can be read.
+func resourceExplorerResourceARNs(c *conns.AWSClient, resourceTypes []string, resourceExplorer *listResourceExplorerModel) arnSourceFunc {
+	return func(ctx context.Context) iter.Seq2[string, error] {
+		conn := c.ResourceExplorer2Client(ctx)
+
+		input := resourceexplorer2.SearchInput{
+			QueryString: aws.String(resourceExplorerQueryString(resourceExplorer.QueryString.ValueString(), resourceTypes, c.AccountID(ctx), c.Region(ctx))),
+		}
+		var optFns []func(*resourceexplorer2.Options)
+		if v := resourceExplorer.ViewARN.ValueString(); v != "" {
+			input.ViewArn = aws.String(v)
+
+			// Searches must be run in the view's Region.
+			if viewARN, err := arn.Parse(v); err == nil {
+				optFns = append(optFns, func(o *resourceexplorer2.Options) {
+					o.Region = viewARN.Region
+				})
+			}
+		}
+
+		return searchResourceARNs(ctx, conn, &input, optFns...)
+	}
+}
+
+// resourceExplorerQueryString returns a Resource Explorer query string which adds resource type, account and
+// Region filters to the specified query. Resource Explorer combines filters with the same prefix using OR.
+func resourceExplorerQueryString(queryString string, resourceTypes []string, accountID, region string) string {
+	var parts []string
+
+	if queryString != "" {
+		parts = append(parts, queryString)
+	}
+	for _, v := range resourceTypes {
+		parts = append(parts, "resourcetype:"+v)
+	}
+	parts = append(parts, "accountid:"+accountID, "region:"+region)
+
+	return strings.Join(parts, " ")
+}
+
+func searchResourceARNs(ctx context.Context, conn *resourceexplorer2.Client, input *resourceexplorer2.SearchInput, optFns ...func(*resourceexplorer2.Options)) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := resourceexplorer2.NewSearchPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, optFns...)
+			if err != nil {
+				yield("", fmt.Errorf("searching Resource Explorer resources: %w", err))
+				return
+			}
+
+			for _, v := range page.Resources {
+				if !yield(aws.ToString(v.Arn), nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+)
+
+func TestResourceExplorerQueryString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		queryString   string
+		resourceTypes []string
+		want          string
+	}{
+		"no query": {
+			resourceTypes: []string{"ecs:cluster"},
+			want:          "resourcetype:ecs:cluster accountid:123456789012 region:us-west-2",
+		},
+		"query": {
+			queryString:   "tag:team=payments",
+			resourceTypes: []string{"ec2:vpc"},
+			want:          "tag:team=payments resourcetype:ec2:vpc accountid:123456789012 region:us-west-2",
+		},
+		"multiple resource types": {
+			queryString:   "prod",
+			resourceTypes: []string{"ecs:service", "ecs:task-definition"},
+			want:          "prod resourcetype:ecs:service resourcetype:ecs:task-definition accountid:123456789012 region:us-west-2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourceExplorerQueryString(tc.queryString, tc.resourceTypes, "123456789012", "us-west-2")
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
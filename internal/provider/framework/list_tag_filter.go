@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+)
+
+const (
+	listResourceTagFilterBlockName = "tag_filter"
+)
+
+type listResourceInjectTagFilterBlockInterceptor struct{}
+
+func (r listResourceInjectTagFilterBlockInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if listSchemaHasRequiredAttributes(response.Schema) {
+			return
+		}
+
+		if response.Schema.Blocks == nil {
+			response.Schema.Blocks = make(map[string]listschema.Block)
+		}
+		if _, ok := response.Schema.Blocks[listResourceTagFilterBlockName]; !ok {
+			// Inject a top-level "tag_filter" block.
+			response.Schema.Blocks[listResourceTagFilterBlockName] = listresourceattribute.TagFilter()
+		}
+	}
+}
+
+// listResourceInjectTagFilterBlock injects a "tag_filter" block into a resource's List schema.
+func listResourceInjectTagFilterBlock() listResourceSchemaInterceptor {
+	return &listResourceInjectTagFilterBlockInterceptor{}
+}
+
+type listTagFilterModel struct {
+	Key    types.String `tfsdk:"key"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// expandListTagFilters returns the Resource Groups Tagging API tag filters specified by any top-level
+// "tag_filter" blocks in a List request's configuration.
+func expandListTagFilters(ctx context.Context, config tfsdk.Config) ([]awstypes.TagFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var tagFilters []listTagFilterModel
+	diags.Append(config.GetAttribute(ctx, path.Root(listResourceTagFilterBlockName), &tagFilters)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.TagFilter
+	for _, v := range tagFilters {
+		apiObjects = append(apiObjects, awstypes.TagFilter{
+			Key:    fwflex.StringFromFramework(ctx, v.Key),
+			Values: fwflex.ExpandFrameworkStringValueList(ctx, v.Values),
+		})
+	}
+
+	return apiObjects, diags
+}
+
+// taggedResourceARNs returns the ARNs of resources of the specified types matching the specified tag filters.
+func taggedResourceARNs(c *conns.AWSClient, resourceTypes []string, tagFilters []awstypes.TagFilter) func(context.Context) iter.Seq2[string, error] {
+	return func(ctx context.Context) iter.Seq2[string, error] {
+		conn := c.ResourceGroupsTaggingAPIClient(ctx)
+
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceTypeFilters: resourceTypes,
+			TagFilters:          tagFilters,
+		}
+
+		return listTaggedResourceARNs(ctx, conn, &input)
+	}
+}
+
+func listTaggedResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing Resource Groups Tagging API resources: %w", err))
+				return
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				if !yield(aws.ToString(v.ResourceARN), nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestListResourceInjectTagFilterBlockInterceptor_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icpt := listResourceInjectTagFilterBlockInterceptor{}
+
+	req := list.ListResourceSchemaRequest{}
+	resp := list.ListResourceSchemaResponse{
+		Schema: listschema.Schema{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{Optional: true},
+			},
+		},
+	}
+
+	icpt.schema(ctx, interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]{
+		request:  &req,
+		response: &resp,
+		when:     After,
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+	}
+
+	if _, ok := resp.Schema.Blocks[listResourceTagFilterBlockName]; !ok {
+		t.Errorf("expected %q block to be injected", listResourceTagFilterBlockName)
+	}
+}
+
+func TestExpandListTagFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrName:   listschema.StringAttribute{Optional: true},
+			names.AttrRegion: listresourceattribute.Region(),
+		},
+		Blocks: map[string]listschema.Block{
+			listResourceTagFilterBlockName: listresourceattribute.TagFilter(),
+		},
+	}
+	tagFilterType := s.Blocks[listResourceTagFilterBlockName].Type().TerraformType(ctx).(tftypes.List)
+	tagFilterElemType := tagFilterType.ElementType.(tftypes.Object)
+	valuesType := tagFilterElemType.AttributeTypes[names.AttrValues]
+
+	configFromTagFilters := func(tagFilters ...tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				names.AttrName:                 tftypes.NewValue(tftypes.String, "example"),
+				names.AttrRegion:               tftypes.NewValue(tftypes.String, nil),
+				listResourceTagFilterBlockName: tftypes.NewValue(tagFilterType, tagFilters),
+			}),
+			Schema: s,
+		}
+	}
+
+	tests := map[string]struct {
+		config tfsdk.Config
+		want   []awstypes.TagFilter
+	}{
+		"no tag filters": {
+			config: configFromTagFilters(),
+		},
+		"tag filters": {
+			config: configFromTagFilters(
+				tftypes.NewValue(tagFilterElemType, map[string]tftypes.Value{
+					names.AttrKey:    tftypes.NewValue(tftypes.String, "team"),
+					names.AttrValues: tftypes.NewValue(valuesType, []tftypes.Value{tftypes.NewValue(tftypes.String, "payments")}),
+				}),
+				tftypes.NewValue(tagFilterElemType, map[string]tftypes.Value{
+					names.AttrKey:    tftypes.NewValue(tftypes.String, "environment"),
+					names.AttrValues: tftypes.NewValue(valuesType, nil),
+				}),
+			),
+			want: []awstypes.TagFilter{
+				{Key: aws.String("team"), Values: []string{"payments"}},
+				{Key: aws.String("environment")},
+			},
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandListTagFilters(ctx, tc.config)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %s", diags)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(awstypes.TagFilter{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			request, diags := removeListConfigBlocks(ctx, list.ListRequest{Config: tc.config}, listResourceTagFilterBlockName)
+			if diags.HasError() {
+				t.Fatalf("unexpected diags: %s", diags)
+			}
+
+			// The list resource's own configuration model must still be readable.
+			var model struct {
+				Name   types.String `tfsdk:"name"`
+				Region types.String `tfsdk:"region"`
+			}
+			if diags := request.Config.Get(ctx, &model); diags.HasError() {
+				t.Fatalf("unexpected diags reading stripped configuration: %s", diags)
+			}
+			if got, want := model.Name.ValueString(), "example"; got != want {
+				t.Errorf("expected name %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -16,3 +17,38 @@ var Region = sync.OnceValue(func() schema.Attribute {
 		Description: names.ListResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var TagFilter = sync.OnceValue(func() schema.Block {
+	return schema.ListNestedBlock{
+		Description: names.ListResourceTagFilterBlockDescription,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Required:    true,
+					Description: "Tag key to match.",
+				},
+				names.AttrValues: schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Tag values to match. If omitted, resources with any value for the tag key match.",
+				},
+			},
+		},
+	}
+})
+
+var ResourceExplorer = sync.OnceValue(func() schema.Block {
+	return schema.SingleNestedBlock{
+		Description: names.ListResourceResourceExplorerBlockDescription,
+		Attributes: map[string]schema.Attribute{
+			"query_string": schema.StringAttribute{
+				Optional:    true,
+				Description: "Resource Explorer search query. Resource type, account and Region filters are added automatically.",
+			},
+			"view_arn": schema.StringAttribute{
+				Optional:    true,
+				Description: "ARN of the view to search. Defaults to the default view for the Region.",
+			},
+		},
+	}
+})
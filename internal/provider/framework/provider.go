@@ -14,6 +14,8 @@ import (
 	"sync"
 	"unique"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
@@ -109,6 +111,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_trace_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file to which a JSON Lines record of every AWS API call made by the provider is appended. " +
+					"Can also be configured with the " + conns.APICallTraceFileEnvVar + " environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -149,6 +156,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_cache": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to prefetch and cache supported resource families with batch API calls when refreshing resources, " +
+					"instead of making one API call per resource.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -204,11 +216,18 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-					`At this time this only includes compliance with required tag keys by resource type. ` +
+					`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 					`Valid values are "error", "warning", and "disabled". ` +
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `Path to a local tag policy document to enforce instead of the effective organizational tag policy. ` +
+					`When set, no AWS API calls are made to retrieve tag policy details. ` +
+					`Only used when tag_policy_compliance is enabled. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -278,6 +297,49 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"assume_role_with_certificate": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_chain_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to a PEM-encoded file containing the intermediate certificates used to validate the certificate.",
+						},
+						"certificate_file": schema.StringAttribute{
+							Required:    true,
+							Description: "Path to a PEM-encoded file containing the X.509 certificate used to authenticate to IAM Roles Anywhere.",
+						},
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"pkcs11_uri": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PKCS#11 URI (RFC 7512) of the private key of the certificate. The URI must contain the module-path query attribute.",
+						},
+						"private_key_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to a PEM-encoded file containing the unencrypted private key of the certificate.",
+						},
+						"profile_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+						},
+						"role_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of an IAM Role to assume using IAM Roles Anywhere.",
+						},
+						"trust_anchor_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+						},
+					},
+				},
+			},
 			"assume_role_with_web_identity": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -354,6 +416,47 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"service_retry": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to override retry behavior for individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request to the service is attempted.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The maximum delay between retries of an AWS API request to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							Validators: []validator.String{
+								positiveDurationValidator{},
+							},
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of AWS API requests per second sent to the service by this provider instance, across all regions.",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0.01),
+							},
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional AWS API error codes for which requests to the service are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to configure, using the same service names as the `endpoints` block, e.g. `route53`.",
+							Validators: []validator.String{
+								serviceRetryServiceValidator{},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -424,6 +527,11 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRPlanFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
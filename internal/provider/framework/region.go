@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -416,6 +417,9 @@ func (r listResourceInjectRegionAttributeInterceptor) schema(ctx context.Context
 	case After:
 		if _, ok := response.Schema.Attributes[names.AttrRegion]; !ok {
 			// Inject a top-level "region" attribute.
+			if response.Schema.Attributes == nil {
+				response.Schema.Attributes = make(map[string]listschema.Attribute)
+			}
 			response.Schema.Attributes[names.AttrRegion] = listresourceattribute.Region()
 		}
 	}
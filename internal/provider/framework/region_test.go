@@ -7,6 +7,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -93,3 +95,33 @@ func getStateAttributeValue(ctx context.Context, t *testing.T, st tfsdk.State, p
 	}
 	return v.ValueString()
 }
+
+func TestListResourceInjectRegionAttributeInterceptor_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	icpt := listResourceInjectRegionAttributeInterceptor{}
+
+	// The list resource schema has no attributes, only blocks.
+	req := list.ListResourceSchemaRequest{}
+	resp := list.ListResourceSchemaResponse{
+		Schema: listschema.Schema{
+			Blocks: map[string]listschema.Block{
+				names.AttrFilter: listschema.ListNestedBlock{},
+			},
+		},
+	}
+
+	icpt.schema(ctx, interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]{
+		request:  &req,
+		response: &resp,
+		when:     After,
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+	}
+
+	if _, ok := resp.Schema.Attributes[names.AttrRegion]; !ok {
+		t.Errorf("expected %q attribute to be injected", names.AttrRegion)
+	}
+}
@@ -333,3 +333,81 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 		}
 	}
 }
+
+// resourceValidateTagPolicyRules validates that tags comply with the key capitalization
+// and value rules of the effective tag policy for a given resource type.
+func resourceValidateTagPolicyRules() resourceModifyPlanInterceptor {
+	return &resourceValidateTagPolicyRulesInterceptor{}
+}
+
+type resourceValidateTagPolicyRulesInterceptor struct{}
+
+func (r resourceValidateTagPolicyRulesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+	if !ok {
+		return
+	}
+
+	policy := c.TagPolicyConfig(ctx)
+	if policy == nil || len(policy.TagRules) == 0 {
+		return
+	}
+
+	switch request, _, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		var planTags, stateTags tftags.Map
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+		opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		if !planTags.IsWhollyKnown() {
+			return
+		}
+
+		allPlanTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		allStateTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, stateTags))
+
+		isCreate := request.State.Raw.IsNull()
+		hasTagsChange := !allPlanTags.Equal(allStateTags)
+
+		if !isCreate && !hasTagsChange {
+			return
+		}
+
+		var enforced, reported []string
+		for _, v := range policy.TagRuleViolations(typeName, allPlanTags) {
+			if v.Enforced {
+				enforced = append(enforced, v.String())
+			} else {
+				reported = append(reported, v.String())
+			}
+		}
+
+		summary := "Noncompliant Tags"
+		if len(enforced) > 0 {
+			detail := fmt.Sprintf("An organizational tag policy enforces the following tag rules for %s: %s", typeName, enforced)
+
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
+		}
+		if len(reported) > 0 {
+			// Rules which are not enforced for the resource type are reported as noncompliant by AWS Organizations,
+			// but do not prevent tagging operations.
+			detail := fmt.Sprintf("An organizational tag policy reports the following tags as noncompliant for %s: %s", typeName, reported)
+			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+		}
+	}
+}
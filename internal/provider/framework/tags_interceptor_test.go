@@ -47,6 +47,26 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 	}
 }
 
+type mockTagPolicyRulesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagPolicyRulesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "error",
+		TagRules: []tftags.TagPolicyRule{
+			{
+				Key:         "Foo",
+				Values:      []string{"allowed"},
+				EnforcedFor: []string{"aws_test"},
+			},
+			{
+				Key: "Bar",
+			},
+		},
+	}
+}
+
 type mockServicePackage struct{}
 
 func (sp mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
@@ -409,3 +429,141 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceValidateTagPolicyRulesInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		if v, ok := meta.(awsClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
+		}
+
+		return ctx
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": tftags.TagsAttribute(),
+		},
+	}
+
+	newValue := func(tags map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tags),
+		})
+	}
+
+	// Compliant tags
+	rawValCompliant := newValue(map[string]tftypes.Value{
+		"Foo": tftypes.NewValue(tftypes.String, "allowed"),
+		"Bar": tftypes.NewValue(tftypes.String, "anything"),
+	})
+
+	// Enforced value violation
+	rawValValue := newValue(map[string]tftypes.Value{
+		"Foo": tftypes.NewValue(tftypes.String, "denied"),
+	})
+
+	// Unenforced capitalization violation
+	rawValKey := newValue(map[string]tftypes.Value{
+		"bar": tftypes.NewValue(tftypes.String, "anything"),
+	})
+
+	tests := []struct {
+		name      string
+		state     tftypes.Value
+		plan      tftypes.Value
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name:  "create, compliant",
+			state: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+			plan:  rawValCompliant,
+		},
+		{
+			name:  "create, enforced value",
+			state: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+			plan:  rawValValue,
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy enforces the following tag rules for aws_test: [Foo: value "denied" is not one of the allowed values ["allowed"]]`,
+			)},
+		},
+		{
+			name:  "create, unenforced capitalization",
+			state: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+			plan:  rawValKey,
+			wantDiags: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy reports the following tags as noncompliant for aws_test: [bar: key must be capitalized as "Bar"]`,
+			)},
+		},
+		{
+			name:  "update, no tags change",
+			state: rawValValue,
+			plan:  rawValValue,
+		},
+		{
+			name:  "update, tags change",
+			state: rawValCompliant,
+			plan:  rawValValue,
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy enforces the following tag rules for aws_test: [Foo: value "denied" is not one of the allowed values ["allowed"]]`,
+			)},
+		},
+		{
+			name:  "destroy",
+			state: rawValValue,
+			plan:  tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagPolicyRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tt.state,
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			}
+
+			r := resourceValidateTagPolicyRules()
+			ctx := bootstrapContext(ctx, opts.c)
+			r.modifyPlan(ctx, opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
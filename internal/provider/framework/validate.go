@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// serviceRetryServiceValidator validates that a string Attribute's value is a service name supported by the endpoints block.
+// It matches the SDKv2 provider's validServiceRetryService.
+type serviceRetryServiceValidator struct{}
+
+func (v serviceRetryServiceValidator) Description(_ context.Context) string {
+	return "value must be a service name supported by the endpoints block"
+}
+
+func (v serviceRetryServiceValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v serviceRetryServiceValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := names.ProviderPackageForAlias(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// positiveDurationValidator validates that a string Attribute's value is a positive duration.
+// It matches the SDKv2 provider's validServiceRetryMaxBackoff.
+type positiveDurationValidator struct{}
+
+func (v positiveDurationValidator) Description(_ context.Context) string {
+	return "value must be a duration greater than zero"
+}
+
+func (v positiveDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positiveDurationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Values which cannot be parsed are reported by the attribute's type.
+	if duration, err := time.ParseDuration(request.ConfigValue.ValueString()); err == nil && duration <= 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServiceRetryServiceValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val                 types.String
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null String": {
+			val: types.StringNull(),
+		},
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"service": {
+			val: types.StringValue("route53"),
+		},
+		"alias": {
+			val: types.StringValue("elasticloadbalancingv2"),
+		},
+		"unsupported": {
+			val: types.StringValue("route66"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a service name supported by the endpoints block, got: route66`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			serviceRetryServiceValidator{}.ValidateString(t.Context(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPositiveDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val                 types.String
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"null String": {
+			val: types.StringNull(),
+		},
+		"positive": {
+			val: types.StringValue("30s"),
+		},
+		"unparseable": {
+			val: types.StringValue("30"),
+		},
+		"zero": {
+			val: types.StringValue("0s"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be a duration greater than zero, got: 0s`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			positiveDurationValidator{}.ValidateString(t.Context(), request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
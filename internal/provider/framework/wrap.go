@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -561,6 +562,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
+		interceptors = append(interceptors, resourceValidateTagPolicyRules())
 	}
 
 	inner, _ := spec.Factory(context.TODO())
@@ -967,11 +969,12 @@ func (w *wrappedListResourceFramework) Metadata(_ context.Context, request resou
 }
 
 type wrappedListResourceSDK struct {
-	inner              inttypes.ListResourceForSDK
-	meta               *conns.AWSClient
-	servicePackageName string
-	spec               *inttypes.ServicePackageSDKListResource
-	interceptors       interceptorInvocations
+	inner                  inttypes.ListResourceForSDK
+	meta                   *conns.AWSClient
+	servicePackageName     string
+	spec                   *inttypes.ServicePackageSDKListResource
+	interceptors           interceptorInvocations
+	listByARNResourceTypes []string
 }
 
 var _ inttypes.ListResourceForSDK = &wrappedListResourceSDK{}
@@ -1000,11 +1003,22 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 		}
 	}
 
+	// Resources which can be read by ARN can be listed by tag using the Resource Groups Tagging API
+	// or by Resource Explorer search.
+	var listByARNResourceTypes []string
+	if v, ok := inner.(framework.ARNLister); ok && v.CanListByARN() {
+		if resourceTypes := tagpolicy.ResourceTypes(spec.TypeName); len(resourceTypes) > 0 {
+			interceptors = append(interceptors, listResourceInjectTagFilterBlock(), listResourceInjectResourceExplorerBlock())
+			listByARNResourceTypes = resourceTypes
+		}
+	}
+
 	return &wrappedListResourceSDK{
-		inner:              inner,
-		servicePackageName: servicePackageName,
-		spec:               spec,
-		interceptors:       interceptors,
+		inner:                  inner,
+		servicePackageName:     servicePackageName,
+		spec:                   spec,
+		interceptors:           interceptors,
+		listByARNResourceTypes: listByARNResourceTypes,
 	}
 }
 
@@ -1073,7 +1087,23 @@ func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequ
 		return
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	f := w.inner.List
+	if len(w.listByARNResourceTypes) > 0 {
+		arns, r, diags := expandListARNSource(ctx, w.meta, w.listByARNResourceTypes, request)
+		if len(diags) > 0 {
+			stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
+		}
+		if diags.HasError() {
+			return
+		}
+
+		request = r
+		if arns != nil {
+			f = listByARN(w.inner.(framework.ARNLister), w.meta, arns)
+		}
+	}
+
+	interceptedListHandler(w.interceptors.resourceList(), f, w.meta)(ctx, request, stream)
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -122,6 +122,26 @@ const (
 	AllCRUDOps = Create | Read | Update | Delete // Interceptor is invoked for all CRUD calls
 )
 
+// String returns the name of a single operation.
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	case CustomizeDiff:
+		return "CustomizeDiff"
+	case Import:
+		return "Import"
+	default:
+		return ""
+	}
+}
+
 type interceptorInvocations []interceptorInvocation
 
 func (s interceptorInvocations) why(why why) interceptorInvocations {
@@ -144,6 +164,10 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+		ctx = conns.NewResourceOperationContext(ctx, why.String())
+		if rd != nil {
+			ctx = conns.NewResourceIDContext(ctx, rd.Id())
+		}
 
 		var interceptors []crudInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
@@ -211,6 +235,10 @@ func interceptedCustomizeDiffHandler(bootstrapContext contextFunc, interceptorIn
 		}
 
 		why := CustomizeDiff
+		ctx = conns.NewResourceOperationContext(ctx, why.String())
+		if d != nil {
+			ctx = conns.NewResourceIDContext(ctx, d.Id())
+		}
 
 		var interceptors []customizeDiffInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
@@ -286,6 +314,10 @@ func interceptedImportHandler(bootstrapContext contextFunc, interceptorInvocatio
 		}
 
 		why := Import
+		ctx = conns.NewResourceOperationContext(ctx, why.String())
+		if d != nil {
+			ctx = conns.NewResourceIDContext(ctx, d.Id())
+		}
 
 		var interceptors []importInterceptorInvocation
 		for _, v := range interceptorInvocations.why(why) {
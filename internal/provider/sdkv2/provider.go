@@ -73,7 +73,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_call_trace_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path to a file to which a JSON Lines record of every AWS API call made by the provider is appended. " +
+						"Can also be configured with the " + conns.APICallTraceFileEnvVar + " environment variable.",
+				},
 				"assume_role":                   assumeRoleSchema(),
+				"assume_role_with_certificate":  assumeRoleWithCertificateSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
@@ -179,6 +186,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"read_cache": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Whether to prefetch and cache supported resource families with batch API calls when refreshing resources, " +
+						"instead of making one API call per resource.",
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -212,6 +225,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_retry": serviceRetrySchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -259,11 +273,19 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
-						`At this time this only includes compliance with required tag keys by resource type. ` +
+						`This includes compliance with required tag keys, tag key capitalization, and allowed tag values by resource type. ` +
 						`Valid values are "error", "warning", and "disabled". ` +
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `Path to a local tag policy document to enforce instead of the effective organizational tag policy. ` +
+						`When set, no AWS API calls are made to retrieve tag policy details. ` +
+						`Only used when tag_policy_compliance is enabled. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -373,6 +395,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadCache:                      d.Get("read_cache").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -449,6 +472,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		}
 	}
 
+	if v, ok := d.GetOk("assume_role_with_certificate"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.AssumeRoleWithCertificate = expandAssumeRoleWithCertificate(ctx, v.([]any)[0].(map[string]any))
+		tflog.Info(ctx, "assume_role_with_certificate configuration set", map[string]any{
+			"tf_aws.assume_role_with_certificate.role_arn":         config.AssumeRoleWithCertificate.RoleARN,
+			"tf_aws.assume_role_with_certificate.profile_arn":      config.AssumeRoleWithCertificate.ProfileARN,
+			"tf_aws.assume_role_with_certificate.trust_anchor_arn": config.AssumeRoleWithCertificate.TrustAnchorARN,
+		})
+	}
+
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.AssumeRoleWithWebIdentity = expandAssumeRoleWithWebIdentity(ctx, v.([]any)[0].(map[string]any))
 		tflog.Info(ctx, "assume_role_with_web_identity configuration set", map[string]any{
@@ -503,10 +535,31 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	if v, ok := d.GetOk("tag_policy_file"); ok {
+		config.TagPolicyFile = v.(string)
+	} else {
+		config.TagPolicyFile = os.Getenv(tftags.TagPolicyFileEnvVar)
+	}
+
+	if v, ok := d.GetOk("api_call_trace_file"); ok {
+		config.APICallTraceFile = v.(string)
+	} else {
+		config.APICallTraceFile = os.Getenv(conns.APICallTraceFileEnvVar)
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retry"); ok && len(v.([]any)) > 0 {
+		serviceRetry, dg := expandServiceRetry(cty.GetAttrPath("service_retry"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetry = serviceRetry
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -540,6 +593,17 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 
+	if config.APICallTraceFile != "" {
+		// Close the API call trace file when Terraform stops the provider.
+		if stopCtx, ok := schema.StopContext(ctx); ok { //nolint:staticcheck // StopContext is the only hook for StopProvider
+			context.AfterFunc(stopCtx, func() {
+				if err := conns.CloseAPICallTraceFiles(); err != nil {
+					log.Printf("[WARN] Closing API call trace file: %s", err)
+				}
+			})
+		}
+	}
+
 	return c, diags
 }
 
@@ -761,6 +825,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: validateRequiredTags(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateTagPolicyRules(),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -989,6 +1058,108 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to override retry behavior for individual AWS services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times an AWS API request to the service is attempted.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries of an AWS API request to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validServiceRetryMaxBackoff,
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum number of AWS API requests per second sent to the service by this provider instance, across all regions.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Additional AWS API error codes for which requests to the service are retried.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to configure, using the same service names as the `endpoints` block, e.g. `route53`.",
+					ValidateFunc: validServiceRetryService,
+				},
+			},
+		},
+	}
+}
+
+func assumeRoleWithCertificateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"assume_role_with_web_identity"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_chain_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to a PEM-encoded file containing the intermediate certificates used to validate the certificate.",
+				},
+				"certificate_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Path to a PEM-encoded file containing the X.509 certificate used to authenticate to IAM Roles Anywhere.",
+				},
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"pkcs11_uri": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "PKCS#11 URI (RFC 7512) of the private key of the certificate. The URI must contain the module-path query attribute.",
+					ExactlyOneOf: []string{"assume_role_with_certificate.0.pkcs11_uri", "assume_role_with_certificate.0.private_key_file"},
+				},
+				"private_key_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path to a PEM-encoded file containing the unencrypted private key of the certificate.",
+					ExactlyOneOf: []string{"assume_role_with_certificate.0.pkcs11_uri", "assume_role_with_certificate.0.private_key_file"},
+				},
+				"profile_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume using IAM Roles Anywhere.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"trust_anchor_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -1154,6 +1325,97 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandAssumeRoleWithCertificate(_ context.Context, tfMap map[string]any) *conns.AssumeRoleWithCertificate {
+	if tfMap == nil {
+		return nil
+	}
+
+	assumeRole := conns.AssumeRoleWithCertificate{}
+
+	if v, ok := tfMap["certificate_chain_file"].(string); ok && v != "" {
+		assumeRole.CertificateChainFile = v
+	}
+
+	if v, ok := tfMap["certificate_file"].(string); ok && v != "" {
+		assumeRole.CertificateFile = v
+	}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		assumeRole.Duration = duration
+	}
+
+	if v, ok := tfMap["pkcs11_uri"].(string); ok && v != "" {
+		assumeRole.PKCS11URI = v
+	}
+
+	if v, ok := tfMap["private_key_file"].(string); ok && v != "" {
+		assumeRole.PrivateKeyFile = v
+	}
+
+	if v, ok := tfMap["profile_arn"].(string); ok && v != "" {
+		assumeRole.ProfileARN = v
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["trust_anchor_arn"].(string); ok && v != "" {
+		assumeRole.TrustAnchorARN = v
+	}
+
+	return &assumeRole
+}
+
+func expandServiceRetry(path cty.Path, tfList []any) (map[string]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRetry := make(map[string]conns.ServiceRetryConfig)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(elementPath.GetAttr("service"), fmt.Sprintf("Unsupported service %q", service)))
+			continue
+		}
+		if _, ok := serviceRetry[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeError(elementPath.GetAttr("service"), fmt.Sprintf("Duplicate service_retry configuration for service %q", servicePackageName)))
+			continue
+		}
+
+		var config conns.ServiceRetryConfig
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v > 0 {
+			config.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			config.MaxBackoff = duration
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok && v > 0 {
+			config.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			config.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		serviceRetry[servicePackageName] = config
+	}
+
+	return serviceRetry, diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
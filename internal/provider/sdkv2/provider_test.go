@@ -7,11 +7,13 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -439,3 +441,89 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandServiceRetry(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList              []any
+		expectedConfig      map[string]conns.ServiceRetryConfig
+		expectedDiagnostics bool
+	}{
+		"service package name": {
+			tfList: []any{
+				map[string]any{
+					"service":               names.Route53,
+					"max_attempts":          40,
+					"max_backoff":           "1m",
+					"requests_per_second":   5.0,
+					"retryable_error_codes": schema.NewSet(schema.HashString, []any{"ConcurrentModificationException"}),
+				},
+			},
+			expectedConfig: map[string]conns.ServiceRetryConfig{
+				names.Route53: {
+					MaxAttempts:         40,
+					MaxBackoff:          time.Minute,
+					RequestsPerSecond:   5,
+					RetryableErrorCodes: []string{"ConcurrentModificationException"},
+				},
+			},
+		},
+		"service alias": {
+			tfList: []any{
+				map[string]any{
+					"service":      "transcribeservice",
+					"max_attempts": 10,
+				},
+			},
+			expectedConfig: map[string]conns.ServiceRetryConfig{
+				names.Transcribe: {
+					MaxAttempts: 10,
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []any{
+				map[string]any{
+					"service": "notaservice",
+				},
+			},
+			expectedConfig:      map[string]conns.ServiceRetryConfig{},
+			expectedDiagnostics: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service":      names.Organizations,
+					"max_attempts": 10,
+				},
+				map[string]any{
+					"service":      names.Organizations,
+					"max_attempts": 20,
+				},
+			},
+			expectedConfig: map[string]conns.ServiceRetryConfig{
+				names.Organizations: {
+					MaxAttempts: 10,
+				},
+			},
+			expectedDiagnostics: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceRetry(cty.GetAttrPath("service_retry"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectedDiagnostics; got != want {
+				t.Errorf("expected error diagnostics %t, got %t: %v", want, got, diags)
+			}
+
+			if diff := cmp.Diff(got, testcase.expectedConfig); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
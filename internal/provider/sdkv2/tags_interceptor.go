@@ -362,3 +362,78 @@ func validateRequiredTags() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+func validateTagPolicyRules() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c)
+		if !ok {
+			return nil
+		}
+
+		policy := c.TagPolicyConfig(ctx)
+		if policy == nil || len(policy.TagRules) == 0 {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				isCreate := d.GetRawState().IsNull()
+				hasTagsChange := d.HasChange(names.AttrTags)
+
+				if !isCreate && !hasTagsChange {
+					return nil
+				}
+
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
+
+				var enforced, reported []string
+				for _, v := range policy.TagRuleViolations(typeName, allTags) {
+					if v.Enforced {
+						enforced = append(enforced, v.String())
+					} else {
+						reported = append(reported, v.String())
+					}
+				}
+
+				summary := "Noncompliant Tags"
+				if len(reported) > 0 {
+					// Rules which are not enforced for the resource type are reported as noncompliant by
+					// AWS Organizations, but do not prevent tagging operations.
+					tflog.Warn(ctx, "Tag Policy Rules Validation", map[string]any{
+						"summary": summary,
+						"detail":  fmt.Sprintf("An organizational tag policy reports the following tags as noncompliant for %s: %s", typeName, reported),
+					})
+				}
+				if len(enforced) == 0 {
+					return nil
+				}
+
+				detail := fmt.Sprintf("An organizational tag policy enforces the following tag rules for %s: %s", typeName, enforced)
+
+				// CustomizeDiff does not support diagnostics (only an error return)
+				switch policy.Severity {
+				case "warning":
+					// Warning diagnostics are only logged
+					tflog.Warn(ctx, "Tag Policy Rules Validation", map[string]any{
+						"summary": summary,
+						"detail":  detail,
+					})
+				default:
+					// Error diagnostics merge summary and detail into a single message
+					return fmt.Errorf("%s - %s", summary, detail)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -28,6 +29,31 @@ func validAssumeRoleDuration(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+// validServiceRetryMaxBackoff validates a string can be parsed as a positive time.Duration
+func validServiceRetryMaxBackoff(v any, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
+
+// validServiceRetryService validates a string is a service name supported by the endpoints block
+func validServiceRetryService(v any, k string) (ws []string, errors []error) {
+	if _, err := names.ProviderPackageForAlias(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an unsupported service: %q", k, v))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
@@ -73,3 +73,39 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidServiceRetryService(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val     any
+		wantErr bool
+	}{
+		"service": {
+			val: "route53",
+		},
+		"alias": {
+			val: "elasticloadbalancingv2",
+		},
+		"unsupported": {
+			val:     "route66",
+			wantErr: true,
+		},
+		"empty": {
+			val:     "",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validServiceRetryService(tc.val, "service")
+
+			if got, want := len(errs) > 0, tc.wantErr; got != want {
+				t.Errorf("validServiceRetryService(%q) errors = %v, want error: %t", tc.val, errs, want)
+			}
+		})
+	}
+}
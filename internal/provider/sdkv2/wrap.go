@@ -6,7 +6,9 @@ package sdkv2
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // Implemented by (schema.ResourceData|schema.ResourceDiff).GetOk().
@@ -79,7 +81,23 @@ func (w *wrappedResource) create(f schema.CreateContextFunc) schema.CreateContex
 }
 
 func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, withReadCache(f), Read)
+}
+
+// withReadCache allows finders called from a resource refresh to be served from the provider's read cache.
+// Create and Update handlers call the unwrapped Read handler, so reads following a change always call the AWS API.
+func withReadCache(f schema.ReadContextFunc) schema.ReadContextFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if c, ok := meta.(*conns.AWSClient); ok && !d.IsNewResource() {
+			ctx = c.ReadCacheContext(ctx)
+		}
+
+		return f(ctx, d, meta)
+	}
 }
 
 func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
@@ -46,8 +46,11 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
 // @Testing(existsTakesT=true, destroyTakesT=true)
+// @Testing(preIdentityVersion="v6.28.0")
+// @Testing(idAttrDuplicates="name")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -56,10 +59,6 @@ func resourceTable() *schema.Resource {
 		UpdateWithoutTimeout: resourceTableUpdate,
 		DeleteWithoutTimeout: resourceTableDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(createTableTimeout),
 			Delete: schema.DefaultTimeout(deleteTableTimeout),
@@ -29,6 +29,9 @@ import (
 // @SDKResource("aws_eip", name="EIP")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Address")
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceEIP() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEIPCreate,
@@ -36,10 +39,6 @@ func resourceEIP() *schema.Resource {
 		UpdateWithoutTimeout: resourceEIPUpdate,
 		DeleteWithoutTimeout: resourceEIPDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(15 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -205,7 +204,8 @@ func resourceEIPCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 
 	if !eipID(d.Id()).IsVPC() {
 		return sdkdiag.AppendErrorf(diags, `with the retirement of EC2-Classic %s domain EC2 EIPs are no longer supported`, awstypes.DomainTypeStandard)
@@ -225,9 +225,24 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s): %s", d.Id(), err)
 	}
 
+	// Force ID to be an Allocation ID if we're on a VPC.
+	// This allows users to import the EIP based on the IP if they are in a VPC.
+	if address.Domain == awstypes.DomainTypeVpc && net.ParseIP(d.Id()) != nil {
+		d.SetId(aws.ToString(address.AllocationId))
+	}
+
+	if err := resourceEIPFlatten(ctx, c, address, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceEIPFlatten(ctx context.Context, c *conns.AWSClient, address *awstypes.Address, d *schema.ResourceData) error {
+	conn := c.EC2Client(ctx)
 	allocationID := aws.ToString(address.AllocationId)
 	d.Set("allocation_id", allocationID)
-	d.Set(names.AttrARN, eipARN(ctx, meta.(*conns.AWSClient), allocationID))
+	d.Set(names.AttrARN, eipARN(ctx, c, allocationID))
 	d.Set(names.AttrAssociationID, address.AssociationId)
 	d.Set("carrier_ip", address.CarrierIp)
 	d.Set("customer_owned_ip", address.CustomerOwnedIp)
@@ -242,17 +257,11 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set("public_ipv4_pool", address.PublicIpv4Pool)
 	d.Set("private_ip", address.PrivateIpAddress)
 	if v := aws.ToString(address.PrivateIpAddress); v != "" {
-		d.Set("private_dns", meta.(*conns.AWSClient).EC2PrivateDNSNameForIP(ctx, v))
+		d.Set("private_dns", c.EC2PrivateDNSNameForIP(ctx, v))
 	}
 	d.Set("public_ip", address.PublicIp)
 	if v := aws.ToString(address.PublicIp); v != "" {
-		d.Set("public_dns", meta.(*conns.AWSClient).EC2PublicDNSNameForIP(ctx, v))
-	}
-
-	// Force ID to be an Allocation ID if we're on a VPC.
-	// This allows users to import the EIP based on the IP if they are in a VPC.
-	if address.Domain == awstypes.DomainTypeVpc && net.ParseIP(d.Id()) != nil {
-		d.SetId(aws.ToString(address.AllocationId))
+		d.Set("public_dns", c.EC2PublicDNSNameForIP(ctx, v))
 	}
 
 	addressAttr, err := findEIPDomainNameAttributeByAllocationID(ctx, conn, d.Id())
@@ -265,12 +274,12 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	case tfawserr.ErrMessageContains(err, "InvalidAction", "not valid for this web service"):
 		d.Set("ptr_record", nil)
 	default:
-		return sdkdiag.AppendErrorf(diags, "reading EC2 EIP (%s) domain name attribute: %s", d.Id(), err)
+		return fmt.Errorf("reading EC2 EIP (%s) domain name attribute: %w", d.Id(), err)
 	}
 
 	setTagsOut(ctx, address.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceEIPUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
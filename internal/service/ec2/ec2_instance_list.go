@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -30,6 +32,24 @@ import (
 func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
 	l := instanceListResource{}
 	l.SetResourceSchema(resourceInstance())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("instance/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		instance, err := findInstanceByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		switch instance.State.Name {
+		case awstypes.InstanceStateNameTerminated, awstypes.InstanceStateNameShuttingDown:
+			return true, nil
+		}
+
+		// Exclude Auto Scaled Instances.
+		return slices.ContainsFunc(instance.Tags, func(v awstypes.Tag) bool {
+			return aws.ToString(v.Key) == "aws:autoscaling:groupName" && aws.ToString(v.Value) != ""
+		}), nil
+	})
 
 	return &l
 }
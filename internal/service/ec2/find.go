@@ -16,6 +16,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -2124,6 +2125,22 @@ func findSecurityGroupByNameAndVPCID(ctx context.Context, conn *ec2.Client, name
 }
 
 func findSecurityGroupByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.SecurityGroup, error) {
+	if v, ok := conns.CachedRead(ctx, "ec2.SecurityGroups."+conn.Options().Region, id, func(ctx context.Context) (map[string]awstypes.SecurityGroup, error) {
+		output, err := findSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{})
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]awstypes.SecurityGroup, len(output))
+		for _, v := range output {
+			m[aws.ToString(v.GroupId)] = v
+		}
+
+		return m, nil
+	}); ok {
+		return &v, nil
+	}
+
 	input := ec2.DescribeSecurityGroupsInput{
 		GroupIds: []string{id},
 	}
@@ -2255,6 +2272,23 @@ func findSecurityGroupIngressRuleByID(ctx context.Context, conn *ec2.Client, id
 }
 
 func findSecurityGroupRulesBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.SecurityGroupRule, error) {
+	if v, ok := conns.CachedRead(ctx, "ec2.SecurityGroupRules."+conn.Options().Region, id, func(ctx context.Context) (map[string][]awstypes.SecurityGroupRule, error) {
+		output, err := findSecurityGroupRules(ctx, conn, &ec2.DescribeSecurityGroupRulesInput{})
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string][]awstypes.SecurityGroupRule)
+		for _, v := range output {
+			groupID := aws.ToString(v.GroupId)
+			m[groupID] = append(m[groupID], v)
+		}
+
+		return m, nil
+	}); ok {
+		return v, nil
+	}
+
 	input := ec2.DescribeSecurityGroupRulesInput{
 		Filters: newAttributeFilterList(map[string]string{
 			"group-id": id,
@@ -2884,6 +2918,22 @@ func findVPCEndpointServiceConfigurations(ctx context.Context, conn *ec2.Client,
 // findRouteTableByID returns the route table corresponding to the specified identifier.
 // Returns NotFoundError if no route table is found.
 func findRouteTableByID(ctx context.Context, conn *ec2.Client, routeTableID string) (*awstypes.RouteTable, error) {
+	if v, ok := conns.CachedRead(ctx, "ec2.RouteTables."+conn.Options().Region, routeTableID, func(ctx context.Context) (map[string]awstypes.RouteTable, error) {
+		output, err := findRouteTables(ctx, conn, &ec2.DescribeRouteTablesInput{})
+		if err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]awstypes.RouteTable, len(output))
+		for _, v := range output {
+			m[aws.ToString(v.RouteTableId)] = v
+		}
+
+		return m, nil
+	}); ok {
+		return &v, nil
+	}
+
 	input := ec2.DescribeRouteTablesInput{
 		RouteTableIds: []string{routeTableID},
 	}
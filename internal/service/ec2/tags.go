@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const eventualConsistencyTimeout = 5 * time.Minute
@@ -105,3 +106,14 @@ func tagsSchemaConflictsWith(conflictsWith []string) *schema.Schema {
 
 	return &v
 }
+
+// listResultDisplayName returns the display name of a resource listed by ARN, formatted as its "Name" tag followed by its ID.
+func listResultDisplayName(d *schema.ResourceData) string {
+	id := d.Id()
+
+	if v, ok := d.Get(names.AttrTags).(map[string]any)["Name"]; ok {
+		return fmt.Sprintf("%s (%s)", v, id)
+	}
+
+	return id
+}
@@ -0,0 +1,24 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_eip" "expected" {
+  count = 2
+
+  domain = "vpc"
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_eip" "not_expected" {
+  domain = "vpc"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,20 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_internet_gateway" "expected" {
+  count = 2
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_internet_gateway" "not_expected" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
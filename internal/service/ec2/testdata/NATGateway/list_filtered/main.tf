@@ -0,0 +1,35 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_nat_gateway" "expected" {
+  count = 2
+
+  connectivity_type = "private"
+  subnet_id         = aws_subnet.test.id
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_nat_gateway" "not_expected" {
+  connectivity_type = "private"
+  subnet_id         = aws_subnet.test.id
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.1.1.0/24"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,28 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_route_table" "expected" {
+  count = 2
+
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_route_table" "not_expected" {
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
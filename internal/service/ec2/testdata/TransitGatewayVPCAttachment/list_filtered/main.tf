@@ -0,0 +1,43 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "expected" {
+  count = 2
+
+  subnet_ids         = [aws_subnet.test[count.index].id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test[count.index].id
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "not_expected" {
+  subnet_ids         = [aws_subnet.test[2].id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test[2].id
+}
+
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  vpc_id     = aws_vpc.test[count.index].id
+  cidr_block = "10.1.1.0/24"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
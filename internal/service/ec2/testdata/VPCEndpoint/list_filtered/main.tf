@@ -0,0 +1,34 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_vpc_endpoint" "expected" {
+  count = 2
+
+  vpc_id       = aws_vpc.test[count.index].id
+  service_name = "com.amazonaws.${data.aws_region.current.region}.s3"
+
+  tags = {
+    expected = var.rName
+  }
+}
+
+resource "aws_vpc_endpoint" "not_expected" {
+  vpc_id       = aws_vpc.test[2].id
+  service_name = "com.amazonaws.${data.aws_region.current.region}.s3"
+}
+
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.1.0.0/16"
+}
+
+data "aws_region" "current" {}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -163,6 +163,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"applianceModeSupport":            testAccTransitGatewayVPCAttachment_applianceModeSupport,
 			"dnsSupport":                      testAccTransitGatewayVPCAttachment_dnsSupport,
 			"ipv6Support":                     testAccTransitGatewayVPCAttachment_ipv6Support,
+			"listFiltered":                    testAccTransitGatewayVPCAttachment_listFiltered,
 			"securityGroupReferencingSupport": testAccTransitGatewayVPCAttachment_securityGroupReferencingSupport,
 			"securityGroupReferencingSupportV5690Diff":                         testAccTransitGatewayVPCAttachment_securityGroupReferencingSupportV5690Diff,
 			"securityGroupReferencingSupportExistingResource":                  testAccTransitGatewayVPCAttachment_securityGroupReferencingSupportExistingResource,
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -26,6 +27,9 @@ import (
 // @SDKResource("aws_ec2_transit_gateway_vpc_attachment", name="Transit Gateway VPC Attachment")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.TransitGatewayVpcAttachment")
+// @Testing(identityTest=false)
 func resourceTransitGatewayVPCAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayVPCAttachmentCreate,
@@ -33,10 +37,6 @@ func resourceTransitGatewayVPCAttachment() *schema.Resource {
 		UpdateWithoutTimeout: resourceTransitGatewayVPCAttachmentUpdate,
 		DeleteWithoutTimeout: resourceTransitGatewayVPCAttachmentDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"appliance_mode_support": {
 				Type:             schema.TypeString,
@@ -185,11 +185,21 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceTransitGatewayVPCAttachmentFlatten(ctx, c, transitGatewayVPCAttachment, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayVPCAttachmentFlatten(ctx context.Context, c *conns.AWSClient, transitGatewayVPCAttachment *awstypes.TransitGatewayVpcAttachment, d *schema.ResourceData) error {
+	conn := c.EC2Client(ctx)
+
 	transitGatewayID := aws.ToString(transitGatewayVPCAttachment.TransitGatewayId)
 	transitGateway, err := findTransitGatewayByID(ctx, conn, transitGatewayID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+		return fmt.Errorf("reading EC2 Transit Gateway (%s): %w", transitGatewayID, err)
 	}
 
 	// We cannot read Transit Gateway Route Tables for Resource Access Manager shared Transit Gateways.
@@ -203,7 +213,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 			if retry.NotFound(err) {
 				transitGatewayDefaultRouteTableAssociation = false
 			} else if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Association (%s): %s", transitGatewayRouteTableAssociationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
+				return fmt.Errorf("reading EC2 Transit Gateway Route Table Association (%s): %w", transitGatewayRouteTableAssociationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
 			}
 		} else {
 			transitGatewayDefaultRouteTableAssociation = false
@@ -215,7 +225,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 			if retry.NotFound(err) {
 				transitGatewayDefaultRouteTablePropagation = false
 			} else if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Propagation (%s): %s", transitGatewayRouteTablePropagationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
+				return fmt.Errorf("reading EC2 Transit Gateway Route Table Propagation (%s): %w", transitGatewayRouteTablePropagationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
 			}
 		} else {
 			transitGatewayDefaultRouteTablePropagation = false
@@ -237,7 +247,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 
 	setTagsOut(ctx, transitGatewayVPCAttachment.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceTransitGatewayVPCAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_ec2_transit_gateway_vpc_attachment")
+func newTransitGatewayVPCAttachmentResourceAsListResource() inttypes.ListResourceForSDK {
+	l := transitGatewayVPCAttachmentListResource{}
+	l.SetResourceSchema(resourceTransitGatewayVPCAttachment())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &transitGatewayVPCAttachmentListResource{}
+
+type transitGatewayVPCAttachmentListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type transitGatewayVPCAttachmentListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *transitGatewayVPCAttachmentListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *transitGatewayVPCAttachmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query transitGatewayVPCAttachmentListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeTransitGatewayVpcAttachmentsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for transitGatewayVPCAttachment, err := range listTransitGatewayVPCAttachments(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			switch transitGatewayVPCAttachment.State {
+			case awstypes.TransitGatewayAttachmentStateDeleting,
+				awstypes.TransitGatewayAttachmentStateDeleted,
+				awstypes.TransitGatewayAttachmentStateFailed,
+				awstypes.TransitGatewayAttachmentStateRejected:
+				continue
+			}
+
+			id := aws.ToString(transitGatewayVPCAttachment.TransitGatewayAttachmentId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, transitGatewayVPCAttachment.Tags)
+			setTagsOut(ctx, transitGatewayVPCAttachment.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceTransitGatewayVPCAttachmentFlatten(ctx, awsClient, &transitGatewayVPCAttachment, rd)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listTransitGatewayVPCAttachments(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) iter.Seq2[awstypes.TransitGatewayVpcAttachment, error] {
+	return func(yield func(awstypes.TransitGatewayVpcAttachment, error) bool) {
+		pages := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.TransitGatewayVpcAttachment{}, fmt.Errorf("listing EC2 Transit Gateway VPC Attachments: %w", err))
+				return
+			}
+
+			for _, transitGatewayVPCAttachment := range page.TransitGatewayVpcAttachments {
+				if !yield(transitGatewayVPCAttachment, nil) {
+					return
+				}
+			}
+		}
+	}
+}
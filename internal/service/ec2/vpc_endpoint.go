@@ -358,7 +358,8 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 
 	vpce, err := findVPCEndpointByID(ctx, conn, d.Id())
 
@@ -372,14 +373,23 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceVPCEndpointFlatten(ctx, c, vpce, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceVPCEndpointFlatten(ctx context.Context, c *conns.AWSClient, vpce *awstypes.VpcEndpoint, d *schema.ResourceData) error {
+	conn := c.EC2Client(ctx)
 	ownerID := aws.ToString(vpce.OwnerId)
-	d.Set(names.AttrARN, vpcEndpointARN(ctx, meta.(*conns.AWSClient), ownerID, d.Id()))
+	d.Set(names.AttrARN, vpcEndpointARN(ctx, c, ownerID, d.Id()))
 	if err := d.Set("dns_entry", flattenDNSEntries(vpce.DnsEntries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting dns_entry: %s", err)
+		return fmt.Errorf("setting dns_entry: %w", err)
 	}
 	if vpce.DnsOptions != nil {
 		if err := d.Set("dns_options", []any{flattenDNSOptions(vpce.DnsOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting dns_options: %s", err)
+			return fmt.Errorf("setting dns_options: %w", err)
 		}
 	} else {
 		d.Set("dns_options", nil)
@@ -411,7 +421,7 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta a
 			if retry.NotFound(err) {
 				d.Set("cidr_blocks", nil)
 			} else {
-				return sdkdiag.AppendErrorf(diags, "reading EC2 Prefix List (%s): %s", serviceName, err)
+				return fmt.Errorf("reading EC2 Prefix List (%s): %w", serviceName, err)
 			}
 		} else {
 			d.Set("cidr_blocks", pl.Cidrs)
@@ -427,30 +437,30 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta a
 	subnetConfigurations, err := findSubnetConfigurationsByNetworkInterfaceIDs(ctx, conn, vpce.NetworkInterfaceIds)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint (%s) subnet configurations: %s", d.Id(), err)
+		return fmt.Errorf("reading VPC Endpoint (%s) subnet configurations: %w", d.Id(), err)
 	}
 
 	if err := d.Set("subnet_configuration", flattenSubnetConfigurations(subnetConfigurations)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting subnet_configuration: %s", err)
+		return fmt.Errorf("setting subnet_configuration: %w", err)
 	}
 
 	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get(names.AttrPolicy).(string), aws.ToString(vpce.PolicyDocument))
 
 	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+		return err
 	}
 
 	policyToSet, err = structure.NormalizeJsonString(policyToSet)
 
 	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+		return err
 	}
 
 	d.Set(names.AttrPolicy, policyToSet)
 
 	setTagsOut(ctx, vpce.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
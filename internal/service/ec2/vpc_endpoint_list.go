@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_vpc_endpoint")
+func newVPCEndpointResourceAsListResource() inttypes.ListResourceForSDK {
+	l := vpcEndpointListResource{}
+	l.SetResourceSchema(resourceVPCEndpoint())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("vpc-endpoint/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(_ context.Context, _ *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		state := d.Get(names.AttrState).(string)
+		return strings.EqualFold(state, vpcEndpointStateDeleted) || strings.EqualFold(state, vpcEndpointStateDeleting), nil
+	})
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &vpcEndpointListResource{}
+
+type vpcEndpointListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type vpcEndpointListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *vpcEndpointListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *vpcEndpointListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query vpcEndpointListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeVpcEndpointsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for vpcEndpoint, err := range listVPCEndpoints(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if state := string(vpcEndpoint.State); strings.EqualFold(state, vpcEndpointStateDeleted) || strings.EqualFold(state, vpcEndpointStateDeleting) {
+				continue
+			}
+
+			id := aws.ToString(vpcEndpoint.VpcEndpointId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, vpcEndpoint.Tags)
+			setTagsOut(ctx, vpcEndpoint.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceVPCEndpointFlatten(ctx, awsClient, &vpcEndpoint, rd)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listVPCEndpoints(ctx context.Context, conn *ec2.Client, input *ec2.DescribeVpcEndpointsInput) iter.Seq2[awstypes.VpcEndpoint, error] {
+	return func(yield func(awstypes.VpcEndpoint, error) bool) {
+		pages := ec2.NewDescribeVpcEndpointsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.VpcEndpoint{}, fmt.Errorf("listing VPC Endpoints: %w", err))
+				return
+			}
+
+			for _, vpcEndpoint := range page.VpcEndpoints {
+				if !yield(vpcEndpoint, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -27,6 +27,9 @@ import (
 // @SDKResource("aws_internet_gateway", name="Internet Gateway")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.InternetGateway")
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceInternetGateway() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInternetGatewayCreate,
@@ -40,10 +43,6 @@ func resourceInternetGateway() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -109,6 +108,14 @@ func resourceInternetGatewayRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Internet Gateway (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceInternetGatewayFlatten(ctx, c, ig, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceInternetGatewayFlatten(ctx context.Context, c *conns.AWSClient, ig *awstypes.InternetGateway, d *schema.ResourceData) error {
 	ownerID := aws.ToString(ig.OwnerId)
 	d.Set(names.AttrARN, internetGatewayARN(ctx, c, ownerID, d.Id()))
 	d.Set(names.AttrOwnerID, ownerID)
@@ -121,7 +128,7 @@ func resourceInternetGatewayRead(ctx context.Context, d *schema.ResourceData, me
 
 	setTagsOut(ctx, ig.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceInternetGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -33,6 +35,17 @@ import (
 func newVPCResourceAsListResource() inttypes.ListResourceForSDK {
 	l := vpcListResource{}
 	l.SetResourceSchema(resourceVPC())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("vpc/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		// Exclude default VPCs. To list default VPCs, use the resource type "aws_default_vpc".
+		vpc, err := findVPCByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(vpc.IsDefault), nil
+	})
 
 	return &l
 }
@@ -31,6 +31,9 @@ import (
 // @SDKResource("aws_nat_gateway", name="NAT Gateway")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.NatGateway")
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceNATGateway() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNATGatewayCreate,
@@ -38,10 +41,6 @@ func resourceNATGateway() *schema.Resource {
 		UpdateWithoutTimeout: resourceNATGatewayUpdate,
 		DeleteWithoutTimeout: resourceNATGatewayDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -353,6 +352,14 @@ func resourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading EC2 NAT Gateway (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceNATGatewayFlatten(ctx, natGateway, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceNATGatewayFlatten(ctx context.Context, natGateway *awstypes.NatGateway, d *schema.ResourceData) error {
 	d.Set("availability_mode", natGateway.AvailabilityMode)
 	d.Set("connectivity_type", natGateway.ConnectivityType)
 	d.Set(names.AttrVPCID, natGateway.VpcId)
@@ -389,11 +396,11 @@ func resourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta an
 		if natGateway.AutoProvisionZones == awstypes.AutoProvisionZonesStateEnabled {
 			d.Set("availability_zone_address", nil)
 		} else if err := d.Set("availability_zone_address", flattenNATGatewayAvailabilityZoneAddresses(natGateway.NatGatewayAddresses)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting availability_zone_address: %s", err)
+			return fmt.Errorf("setting availability_zone_address: %w", err)
 		}
 
 		if err := d.Set("regional_nat_gateway_address", flattenRegionalNATGatewayAddress(natGateway.NatGatewayAddresses)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting regional_nat_gateway_address: %s", err)
+			return fmt.Errorf("setting regional_nat_gateway_address: %w", err)
 		}
 		d.Set("regional_nat_gateway_auto_mode", natGateway.AutoProvisionZones)
 		d.Set("route_table_id", natGateway.RouteTableId)
@@ -401,7 +408,7 @@ func resourceNATGatewayRead(ctx context.Context, d *schema.ResourceData, meta an
 
 	setTagsOut(ctx, natGateway.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
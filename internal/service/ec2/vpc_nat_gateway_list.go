@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_nat_gateway")
+func newNATGatewayResourceAsListResource() inttypes.ListResourceForSDK {
+	l := natGatewayListResource{}
+	l.SetResourceSchema(resourceNATGateway())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("natgateway/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		natGateway, err := findNATGatewayByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return natGateway.State == awstypes.NatGatewayStateDeleting, nil
+	})
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &natGatewayListResource{}
+
+type natGatewayListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type natGatewayListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *natGatewayListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *natGatewayListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query natGatewayListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeNatGatewaysInput
+	if diags := fwflex.Expand(ctx, query.Filters, &input.Filter); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// If no state filter is set, default to all states except deleting and deleted.
+	if !slices.ContainsFunc(input.Filter, func(v awstypes.Filter) bool {
+		return aws.ToString(v.Name) == names.AttrState
+	}) {
+		input.Filter = append(input.Filter, awstypes.Filter{
+			Name: aws.String(names.AttrState),
+			Values: enum.Slice(slices.DeleteFunc(enum.EnumValues[awstypes.NatGatewayState](), func(v awstypes.NatGatewayState) bool {
+				return v == awstypes.NatGatewayStateDeleting || v == awstypes.NatGatewayStateDeleted
+			})...),
+		})
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for natGateway, err := range listNATGateways(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(natGateway.NatGatewayId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, natGateway.Tags)
+			setTagsOut(ctx, natGateway.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceNATGatewayFlatten(ctx, &natGateway, rd)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listNATGateways(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNatGatewaysInput) iter.Seq2[awstypes.NatGateway, error] {
+	return func(yield func(awstypes.NatGateway, error) bool) {
+		pages := ec2.NewDescribeNatGatewaysPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.NatGateway{}, fmt.Errorf("listing EC2 NAT Gateways: %w", err))
+				return
+			}
+
+			for _, natGateway := range page.NatGateways {
+				if !yield(natGateway, nil) {
+					return
+				}
+			}
+		}
+	}
+}
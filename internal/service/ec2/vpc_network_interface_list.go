@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_network_interface")
+func newNetworkInterfaceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := networkInterfaceListResource{}
+	l.SetResourceSchema(resourceNetworkInterface())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("network-interface/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		// Exclude Network Interfaces managed by AWS services.
+		networkInterface, err := findNetworkInterfaceByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(networkInterface.RequesterManaged), nil
+	})
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &networkInterfaceListResource{}
+
+type networkInterfaceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type networkInterfaceListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *networkInterfaceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *networkInterfaceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query networkInterfaceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeNetworkInterfacesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// If no requester-managed filter is set, exclude Network Interfaces managed by AWS services.
+	if !slices.ContainsFunc(input.Filters, func(v awstypes.Filter) bool {
+		return aws.ToString(v.Name) == "requester-managed"
+	}) {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Name:   aws.String("requester-managed"),
+			Values: []string{"false"},
+		})
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for networkInterface, err := range listNetworkInterfaces(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(networkInterface.NetworkInterfaceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, networkInterface.TagSet)
+			setTagsOut(ctx, networkInterface.TagSet)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceNetworkInterfaceFlatten(ctx, awsClient, &networkInterface, rd)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) iter.Seq2[awstypes.NetworkInterface, error] {
+	return func(yield func(awstypes.NetworkInterface, error) bool) {
+		pages := ec2.NewDescribeNetworkInterfacesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.NetworkInterface{}, fmt.Errorf("listing EC2 Network Interfaces: %w", err))
+				return
+			}
+
+			for _, networkInterface := range page.NetworkInterfaces {
+				if !yield(networkInterface, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -228,6 +228,15 @@ func resourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading Route Table (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceRouteTableFlatten(ctx, c, routeTable, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceRouteTableFlatten(ctx context.Context, c *conns.AWSClient, routeTable *awstypes.RouteTable, d *schema.ResourceData) error {
+	conn := c.EC2Client(ctx)
 	ownerID := aws.ToString(routeTable.OwnerId)
 	d.Set(names.AttrARN, routeTableARN(ctx, c, ownerID, d.Id()))
 	d.Set(names.AttrOwnerID, ownerID)
@@ -236,17 +245,17 @@ func resourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta an
 		propagatingVGWs = append(propagatingVGWs, aws.ToString(v.GatewayId))
 	}
 	if err := d.Set("propagating_vgws", propagatingVGWs); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting propagating_vgws: %s", err)
+		return fmt.Errorf("setting propagating_vgws: %w", err)
 	}
 	if err := d.Set("route", flattenRoutes(ctx, conn, d, routeTable.Routes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+		return fmt.Errorf("setting route: %w", err)
 	}
 	d.Set(names.AttrVPCID, routeTable.VpcId)
 
 	// Ignore the AmazonFSx service tag in addition to standard ignores.
 	setTagsOut(ctx, svcTags(keyValueTags(ctx, routeTable.Tags).Ignore(tftags.New(ctx, []string{"AmazonFSx"}))))
 
-	return diags
+	return nil
 }
 
 func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// @SDKListResource("aws_route_table")
+func newRouteTableResourceAsListResource() inttypes.ListResourceForSDK {
+	l := routeTableListResource{}
+	l.SetResourceSchema(resourceRouteTable())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("route-table/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		// Exclude main Route Tables. To list main Route Tables, use the resource type "aws_default_route_table".
+		routeTable, err := findRouteTableByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return slices.ContainsFunc(routeTable.Associations, func(v awstypes.RouteTableAssociation) bool {
+			return aws.ToBool(v.Main)
+		}), nil
+	})
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &routeTableListResource{}
+
+type routeTableListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type routeTableListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *routeTableListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *routeTableListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	attributes := []attribute.KeyValue{
+		otelaws.RegionAttr(awsClient.Region(ctx)),
+	}
+	for _, attribute := range attributes {
+		ctx = tflog.SetField(ctx, string(attribute.Key), attribute.Value.AsInterface())
+	}
+
+	var query routeTableListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeRouteTablesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for routeTable, err := range listRouteTables(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Exclude main Route Tables. To list main Route Tables, use the resource type "aws_default_route_table".
+			if slices.ContainsFunc(routeTable.Associations, func(v awstypes.RouteTableAssociation) bool {
+				return aws.ToBool(v.Main)
+			}) {
+				continue
+			}
+
+			id := aws.ToString(routeTable.RouteTableId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, routeTable.Tags)
+			setTagsOut(ctx, routeTable.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceRouteTableFlatten(ctx, awsClient, &routeTable, rd)
+			if retry.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listRouteTables(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteTablesInput) iter.Seq2[awstypes.RouteTable, error] {
+	return func(yield func(awstypes.RouteTable, error) bool) {
+		pages := ec2.NewDescribeRouteTablesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.RouteTable{}, fmt.Errorf("listing Route Tables: %w", err))
+				return
+			}
+
+			for _, routeTable := range page.RouteTables {
+				if !yield(routeTable, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -280,6 +280,14 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceSecurityGroupFlatten(ctx, c, sg, d); err != nil {
+		diags = sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceSecurityGroupFlatten(ctx context.Context, c *conns.AWSClient, sg *awstypes.SecurityGroup, d *schema.ResourceData) error {
 	remoteIngressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
 	remoteEgressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
 
@@ -300,16 +308,16 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrVPCID, sg.VpcId)
 
 	if err := d.Set("ingress", ingressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+		return fmt.Errorf("setting ingress: %w", err)
 	}
 
 	if err := d.Set("egress", egressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+		return fmt.Errorf("setting egress: %w", err)
 	}
 
 	setTagsOut(ctx, sg.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkListResource("aws_vpc_security_group_egress_rule")
+func newSecurityGroupEgressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	l := &securityGroupEgressRuleListResource{}
+	l.securityGroupRule = &l.securityGroupEgressRuleResource
+
+	return l
+}
+
+var _ list.ListResource = &securityGroupEgressRuleListResource{}
+
+type securityGroupEgressRuleListResource struct {
+	securityGroupEgressRuleResource
+	framework.WithList
+}
+
+func (l *securityGroupEgressRuleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = securityGroupRuleListResourceConfigSchema(ctx)
+}
+
+func (l *securityGroupEgressRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	listSecurityGroupRuleResources(ctx, &l.securityGroupRuleResource, &l.WithList, request, stream, true)
+}
@@ -290,7 +290,15 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 		return
 	}
 
-	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
+	r.flatten(ctx, output, &data)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRuleResource) flatten(ctx context.Context, output *awstypes.SecurityGroupRule, data *securityGroupRuleResourceModel) {
+	data.ARN = r.securityGroupRuleARN(ctx, aws.ToString(output.SecurityGroupRuleId))
 	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
 	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
 	data.Description = fwflex.StringToFramework(ctx, output.Description)
@@ -311,10 +319,6 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	} else {
 		data.ToPort = fwflex.Int32ToFrameworkInt64(ctx, output.ToPort)
 	}
-
-	setTagsOut(ctx, output.Tags)
-
-	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 func (r *securityGroupRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkListResource("aws_vpc_security_group_ingress_rule")
+func newSecurityGroupIngressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	l := &securityGroupIngressRuleListResource{}
+	l.securityGroupRule = &l.securityGroupIngressRuleResource
+
+	return l
+}
+
+var _ list.ListResource = &securityGroupIngressRuleListResource{}
+
+type securityGroupIngressRuleListResource struct {
+	securityGroupIngressRuleResource
+	framework.WithList
+}
+
+func (l *securityGroupIngressRuleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = securityGroupRuleListResourceConfigSchema(ctx)
+}
+
+func (l *securityGroupIngressRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	listSecurityGroupRuleResources(ctx, &l.securityGroupRuleResource, &l.WithList, request, stream, false)
+}
+
+type securityGroupRuleListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func securityGroupRuleListResourceConfigSchema(ctx context.Context) listschema.Schema {
+	return listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+// listSecurityGroupRuleResources streams the ingress or egress VPC security group rules matching the list configuration.
+func listSecurityGroupRuleResources(ctx context.Context, r *securityGroupRuleResource, l *framework.WithList, request list.ListRequest, stream *list.ListResultsStream, isEgress bool) {
+	awsClient := r.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query securityGroupRuleListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeSecurityGroupRulesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for securityGroupRule, err := range listSecurityGroupRules(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if aws.ToBool(securityGroupRule.IsEgress) != isEgress {
+				continue
+			}
+
+			id := aws.ToString(securityGroupRule.SecurityGroupRuleId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			var data securityGroupRuleResourceModel
+			l.SetResult(ctx, awsClient, &data, &result, func() {
+				r.flatten(ctx, &securityGroupRule, &data)
+				data.setID()
+
+				tags := keyValueTags(ctx, securityGroupRule.Tags)
+				setTagsOut(ctx, securityGroupRule.Tags)
+
+				if v, ok := tags["Name"]; ok {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), id)
+				} else {
+					result.DisplayName = id
+				}
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listSecurityGroupRules(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupRulesInput) iter.Seq2[awstypes.SecurityGroupRule, error] {
+	return func(yield func(awstypes.SecurityGroupRule, error) bool) {
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.SecurityGroupRule{}, fmt.Errorf("listing VPC Security Group Rules: %w", err))
+				return
+			}
+
+			for _, securityGroupRule := range page.SecurityGroupRules {
+				if !yield(securityGroupRule, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -32,6 +34,17 @@ import (
 func newSubnetResourceAsListResource() inttypes.ListResourceForSDK {
 	l := subnetListResource{}
 	l.SetResourceSchema(resourceSubnet())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("subnet/"))
+	l.SetDisplayName(listResultDisplayName)
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		// Exclude default Subnets. To list default Subnets, use the resource type "aws_default_subnet".
+		subnet, err := findSubnetByID(ctx, awsClient.EC2Client(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(subnet.DefaultForAz), nil
+	})
 
 	return &l
 }
@@ -219,22 +219,30 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading ECR Repository (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceRepositoryFlatten(ctx, repository, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceRepositoryFlatten(_ context.Context, repository *types.Repository, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, repository.RepositoryArn)
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenRepositoryEncryptionConfiguration(repository.EncryptionConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
+		return fmt.Errorf("setting encryption_configuration: %w", err)
 	}
 	if err := d.Set("image_scanning_configuration", flattenImageScanningConfiguration(repository.ImageScanningConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting image_scanning_configuration: %s", err)
+		return fmt.Errorf("setting image_scanning_configuration: %w", err)
 	}
 	d.Set("image_tag_mutability", repository.ImageTagMutability)
 	if err := d.Set("image_tag_mutability_exclusion_filter", flattenImageTagMutabilityExclusionFilters(repository.ImageTagMutabilityExclusionFilters)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting image_tag_mutability_exclusion_filter: %s", err)
+		return fmt.Errorf("setting image_tag_mutability_exclusion_filter: %w", err)
 	}
 	d.Set(names.AttrName, repository.RepositoryName)
 	d.Set("registry_id", repository.RegistryId)
 	d.Set("repository_url", repository.RepositoryUri)
 
-	return diags
+	return nil
 }
 
 func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -28,7 +29,11 @@ import (
 
 // @SDKResource("aws_ecs_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
 // @ArnFormat("cluster/{name}")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;types.Cluster")
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -329,16 +334,23 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	d.Set(names.AttrName, d.Id())
-
-	region := d.Get(names.AttrRegion).(string)
-	d.SetId(arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Service:   "ecs",
-		Resource:  "cluster/" + d.Id(),
-	}.String())
+	// Import by cluster name.
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		d.Set(names.AttrName, id)
+
+		region := d.Get(names.AttrRegion).(string)
+		d.SetId(arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Service:   "ecs",
+			Resource:  "cluster/" + id,
+		}.String())
+	}
+
+	if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
@@ -46,6 +47,11 @@ import (
 
 // @SDKResource("aws_ecs_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;types.Service")
+// @Testing(importIgnore="task_definition;wait_for_steady_state")
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceService() *schema.Resource {
 	// Resource with v0 schema (provider v5.58.0).
 	resourceV0 := &schema.Resource{
@@ -1989,34 +1995,65 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
-	}
-	clusterName := parts[0]
-	serviceName := parts[1]
-	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
-
-	region := d.Get(names.AttrRegion).(string)
-
-	clusterARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("cluster/%s", clusterName),
-	}.String()
-	d.Set("cluster", clusterARN)
-
-	serviceARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
-	}.String()
-	d.SetId(serviceARN)
-	d.Set(names.AttrARN, serviceARN)
+	// Import by "cluster-name/service-name".
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		parts := strings.Split(id, "/")
+		if len(parts) != 2 {
+			return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name' or service ARN", id)
+		}
+		clusterName := parts[0]
+		serviceName := parts[1]
+		log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
+
+		region := d.Get(names.AttrRegion).(string)
+
+		serviceARN := arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			Service:   "ecs",
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
+		}.String()
+		d.SetId(serviceARN)
+	}
+
+	if err := importer.RegionalARN(ctx, d, importer.IdentitySpec(ctx)); err != nil {
+		return nil, err
+	}
+
+	serviceARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	switch parts := strings.Split(serviceARN.Resource, "/"); len(parts) {
+	case 3:
+		// The cluster is part of the long format service ARN.
+		clusterARN := arn.ARN{
+			Partition: serviceARN.Partition,
+			Region:    serviceARN.Region,
+			Service:   serviceARN.Service,
+			AccountID: serviceARN.AccountID,
+			Resource:  "cluster/" + parts[1],
+		}.String()
+		d.Set("cluster", clusterARN)
+	case 2:
+		// The short format service ARN doesn't include the cluster.
+		// Use the configured cluster if there is one, otherwise look the service up in the default cluster.
+		if d.Get("cluster").(string) == "" {
+			conn := meta.(*conns.AWSClient).ECSClient(ctx)
+
+			service, err := findServiceNoTagsByTwoPartKey(ctx, conn, d.Id(), "")
+
+			if err != nil {
+				return nil, fmt.Errorf("reading ECS Service (%s): %w", d.Id(), err)
+			}
+
+			d.Set("cluster", service.ClusterArn)
+		}
+	default:
+		return nil, fmt.Errorf("unable to determine ECS Cluster from ECS Service ARN (%s)", d.Id())
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
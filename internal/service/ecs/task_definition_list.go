@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ecs_task_definition")
+func newTaskDefinitionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := taskDefinitionListResource{}
+	l.SetResourceSchema(resourceTaskDefinition())
+	l.SetDisplayName(func(d *schema.ResourceData) string {
+		return d.Get(names.AttrFamily).(string)
+	})
+
+	return &l
+}
+
+type taskDefinitionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type taskDefinitionListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *taskDefinitionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	var query taskDefinitionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := ecs.ListTaskDefinitionFamiliesInput{
+			Status: awstypes.TaskDefinitionFamilyStatusActive,
+		}
+		for family, err := range listTaskDefinitionFamilies(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrFamily), family)
+
+			result := request.NewListResult(ctx)
+
+			// Describing a task definition by family returns its latest ACTIVE revision.
+			rd := l.ResourceData()
+			rd.SetId(family)
+			rd.Set(names.AttrARN, family)
+
+			tflog.Info(ctx, "Reading resource")
+			diags := resourceTaskDefinitionRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading ECS Task Definition", map[string]any{
+					names.AttrFamily: family,
+					"diags":          sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = family
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listTaskDefinitionFamilies(ctx context.Context, conn *ecs.Client, input *ecs.ListTaskDefinitionFamiliesInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := ecs.NewListTaskDefinitionFamiliesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing ECS Task Definition Families: %w", err))
+				return
+			}
+
+			for _, family := range page.Families {
+				if !yield(family, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ecs_cluster" "test" {
+  count = 2
+
+  name = "${var.rName}-${count.index}"
+
+  tags = {
+    TagFilter = var.rName
+  }
+}
+
+resource "aws_ecs_cluster" "untagged" {
+  name = "${var.rName}-untagged"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -6,6 +6,7 @@ package efs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -31,6 +32,9 @@ import (
 
 // @SDKResource("aws_efs_file_system", name="File System")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(hasExistsFunction=false)
+// @Testing(preIdentityVersion="v6.28.0")
 func resourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
@@ -38,10 +42,6 @@ func resourceFileSystem() *schema.Resource {
 		UpdateWithoutTimeout: resourceFileSystemUpdate,
 		DeleteWithoutTimeout: resourceFileSystemDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -263,7 +263,8 @@ func resourceFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceFileSystemRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EFSClient(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EFSClient(ctx)
 
 	fs, err := findFileSystemByID(ctx, conn, d.Id())
 
@@ -277,11 +278,21 @@ func resourceFileSystemRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading EFS File System (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceFileSystemFlatten(ctx, c, fs, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceFileSystemFlatten(ctx context.Context, c *conns.AWSClient, fs *awstypes.FileSystemDescription, d *schema.ResourceData) error {
+	conn := c.EFSClient(ctx)
+
 	d.Set(names.AttrARN, fs.FileSystemArn)
 	d.Set("availability_zone_id", fs.AvailabilityZoneId)
 	d.Set("availability_zone_name", fs.AvailabilityZoneName)
 	d.Set("creation_token", fs.CreationToken)
-	d.Set(names.AttrDNSName, meta.(*conns.AWSClient).RegionalHostname(ctx, d.Id()+".efs"))
+	d.Set(names.AttrDNSName, c.RegionalHostname(ctx, d.Id()+".efs"))
 	d.Set(names.AttrEncrypted, fs.Encrypted)
 	d.Set(names.AttrKMSKeyID, fs.KmsKeyId)
 	d.Set(names.AttrName, fs.Name)
@@ -289,11 +300,11 @@ func resourceFileSystemRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set(names.AttrOwnerID, fs.OwnerId)
 	d.Set("performance_mode", fs.PerformanceMode)
 	if err := d.Set("protection", flattenFileSystemProtectionDescription(fs.FileSystemProtection)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting protection: %s", err)
+		return fmt.Errorf("setting protection: %w", err)
 	}
 	d.Set("provisioned_throughput_in_mibps", fs.ProvisionedThroughputInMibps)
 	if err := d.Set("size_in_bytes", flattenFileSystemSize(fs.SizeInBytes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting size_in_bytes: %s", err)
+		return fmt.Errorf("setting size_in_bytes: %w", err)
 	}
 	d.Set("throughput_mode", fs.ThroughputMode)
 
@@ -304,14 +315,14 @@ func resourceFileSystemRead(ctx context.Context, d *schema.ResourceData, meta an
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EFS File System (%s) lifecycle configuration: %s", d.Id(), err)
+		return fmt.Errorf("reading EFS File System (%s) lifecycle configuration: %w", d.Id(), err)
 	}
 
 	if err := d.Set("lifecycle_policy", flattenLifecyclePolicies(output.LifecyclePolicies)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting lifecycle_policy: %s", err)
+		return fmt.Errorf("setting lifecycle_policy: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceFileSystemUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_efs_file_system")
+func newFileSystemResourceAsListResource() inttypes.ListResourceForSDK {
+	l := fileSystemListResource{}
+	l.SetResourceSchema(resourceFileSystem())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("file-system/"))
+	l.SetDisplayName(func(d *schema.ResourceData) string {
+		if v := d.Get(names.AttrName).(string); v != "" {
+			return fmt.Sprintf("%s (%s)", v, d.Id())
+		}
+
+		return d.Id()
+	})
+	l.SetExcludeFromARN(func(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) (bool, error) {
+		fileSystem, err := findFileSystemByID(ctx, awsClient.EFSClient(ctx), d.Id())
+		if err != nil {
+			return false, err
+		}
+
+		return fileSystem.LifeCycleState == awstypes.LifeCycleStateDeleting, nil
+	})
+
+	return &l
+}
+
+type fileSystemListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type fileSystemListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *fileSystemListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EFSClient(ctx)
+
+	var query fileSystemListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input efs.DescribeFileSystemsInput
+		for fileSystem, err := range listFileSystems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			switch fileSystem.LifeCycleState {
+			case awstypes.LifeCycleStateDeleting, awstypes.LifeCycleStateDeleted:
+				continue
+			}
+
+			id := aws.ToString(fileSystem.FileSystemId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceFileSystemFlatten(ctx, awsClient, &fileSystem, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v := aws.ToString(fileSystem.Name); v != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v, id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listFileSystems(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput) iter.Seq2[awstypes.FileSystemDescription, error] {
+	return func(yield func(awstypes.FileSystemDescription, error) bool) {
+		pages := efs.NewDescribeFileSystemsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.FileSystemDescription{}, fmt.Errorf("listing EFS File Systems: %w", err))
+				return
+			}
+
+			for _, fileSystem := range page.FileSystems {
+				if !yield(fileSystem, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_efs_file_system" "test" {
+  count = 2
+
+  creation_token = "${var.rName}-${count.index}"
+
+  tags = {
+    Name = "${var.rName}-${count.index}"
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -33,6 +33,15 @@ import (
 
 // @SDKResource("aws_eks_addon", name="Add-On")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_name")
+// @IdentityAttribute("addon_name")
+// @IdAttrFormat("{cluster_name}:{addon_name}")
+// @ImportIDHandler("addonImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Addon")
+// @Testing(name="Addon")
+// @Testing(preIdentityVersion="v6.28.0")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preCheck="testAccPreCheckAddon")
 func resourceAddon() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAddonCreate,
@@ -40,10 +49,6 @@ func resourceAddon() *schema.Resource {
 		UpdateWithoutTimeout: resourceAddonUpdate,
 		DeleteWithoutTimeout: resourceAddonDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -581,3 +586,22 @@ func errorDetailsError(apiObjects []types.ErrorDetail) error {
 
 	return errors.Join(errs...)
 }
+
+type addonImportID struct{}
+
+func (addonImportID) Create(d *schema.ResourceData) string {
+	return AddonCreateResourceID(d.Get(names.AttrClusterName).(string), d.Get("addon_name").(string))
+}
+
+func (addonImportID) Parse(id string) (string, map[string]string, error) {
+	clusterName, addonName, err := AddonParseResourceID(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		names.AttrClusterName: clusterName,
+		"addon_name":          addonName,
+	}
+	return id, result, nil
+}
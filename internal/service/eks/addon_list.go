@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_eks_addon")
+func newAddonResourceAsListResource() inttypes.ListResourceForSDK {
+	l := addonListResource{}
+	l.SetResourceSchema(resourceAddon())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &addonListResource{}
+
+type addonListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type addonListResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+}
+
+func (l *addonListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrClusterName: listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *addonListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EKSClient(ctx)
+
+	var query addonListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var clusterNames iter.Seq2[string, error]
+		if v := query.ClusterName.ValueString(); v != "" {
+			clusterNames = func(yield func(string, error) bool) {
+				yield(v, nil)
+			}
+		} else {
+			var input eks.ListClustersInput
+			clusterNames = listClusterNames(ctx, conn, &input)
+		}
+
+		for clusterName, err := range clusterNames {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrClusterName), clusterName)
+
+			input := eks.ListAddonsInput{
+				ClusterName: aws.String(clusterName),
+			}
+			for addonName, err := range listAddonNames(ctx, conn, &input) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("addon_name"), addonName)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(AddonCreateResourceID(clusterName, addonName))
+
+				tflog.Info(ctx, "Reading resource")
+				diags := resourceAddonRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading EKS Add-On", map[string]any{
+						names.AttrClusterName: clusterName,
+						"addon_name":          addonName,
+						"diags":               sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				result.DisplayName = fmt.Sprintf("%s/%s", clusterName, addonName)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func listAddonNames(ctx context.Context, conn *eks.Client, input *eks.ListAddonsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := eks.NewListAddonsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing EKS Add-Ons (%s): %w", aws.ToString(input.ClusterName), err))
+				return
+			}
+
+			for _, name := range page.Addons {
+				if !yield(name, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -33,6 +33,12 @@ import (
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Cluster")
+// @Testing(preIdentityVersion="v6.28.0")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(idAttrDuplicates="name")
+// @Testing(importIgnore="bootstrap_self_managed_addons")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -40,10 +46,6 @@ func resourceCluster() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterUpdate,
 		DeleteWithoutTimeout: resourceClusterDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -35,6 +35,13 @@ import (
 
 // @SDKResource("aws_eks_node_group", name="Node Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_name")
+// @IdentityAttribute("node_group_name")
+// @IdAttrFormat("{cluster_name}:{node_group_name}")
+// @ImportIDHandler("nodeGroupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Nodegroup")
+// @Testing(preIdentityVersion="v6.28.0")
+// @Testing(preCheck="testAccPreCheck")
 func resourceNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNodeGroupCreate,
@@ -42,10 +49,6 @@ func resourceNodeGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceNodeGroupUpdate,
 		DeleteWithoutTimeout: resourceNodeGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -1288,3 +1291,22 @@ func flattenTaints(apiObjects []types.Taint) []any {
 
 	return tfList
 }
+
+type nodeGroupImportID struct{}
+
+func (nodeGroupImportID) Create(d *schema.ResourceData) string {
+	return NodeGroupCreateResourceID(d.Get(names.AttrClusterName).(string), d.Get("node_group_name").(string))
+}
+
+func (nodeGroupImportID) Parse(id string) (string, map[string]string, error) {
+	clusterName, nodeGroupName, err := NodeGroupParseResourceID(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		names.AttrClusterName: clusterName,
+		"node_group_name":     nodeGroupName,
+	}
+	return id, result, nil
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_eks_node_group")
+func newNodeGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := nodeGroupListResource{}
+	l.SetResourceSchema(resourceNodeGroup())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &nodeGroupListResource{}
+
+type nodeGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type nodeGroupListResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+}
+
+func (l *nodeGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrClusterName: listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (l *nodeGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EKSClient(ctx)
+
+	var query nodeGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	clusterName := query.ClusterName.ValueString()
+	ctx = tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrClusterName), clusterName)
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := eks.ListNodegroupsInput{
+			ClusterName: aws.String(clusterName),
+		}
+		for nodeGroupName, err := range listNodeGroupNames(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("node_group_name"), nodeGroupName)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(NodeGroupCreateResourceID(clusterName, nodeGroupName))
+
+			tflog.Info(ctx, "Reading resource")
+			diags := resourceNodeGroupRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading EKS Node Group", map[string]any{
+					names.AttrClusterName: clusterName,
+					"node_group_name":     nodeGroupName,
+					"diags":               sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = nodeGroupName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listNodeGroupNames(ctx context.Context, conn *eks.Client, input *eks.ListNodegroupsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := eks.NewListNodegroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing EKS Node Groups (%s): %w", aws.ToString(input.ClusterName), err))
+				return
+			}
+
+			for _, name := range page.Nodegroups {
+				if !yield(name, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -143,6 +143,16 @@ func detachPolicyFromRole(ctx context.Context, conn *iam.Client, role, policyARN
 }
 
 func findAttachedRolePolicyByTwoPartKey(ctx context.Context, conn *iam.Client, roleName, policyARN string) (*awstypes.AttachedPolicy, error) {
+	if v, ok := conns.CachedRead(ctx, "iam.AttachedRolePolicies", roleName, func(ctx context.Context) (map[string][]awstypes.AttachedPolicy, error) {
+		return findAttachedRolePoliciesByRoleName(ctx, conn)
+	}); ok {
+		for _, v := range v {
+			if aws.ToString(v.PolicyArn) == policyARN {
+				return &v, nil
+			}
+		}
+	}
+
 	input := &iam.ListAttachedRolePoliciesInput{
 		RoleName: aws.String(roleName),
 	}
@@ -190,6 +200,29 @@ func findAttachedRolePolicies(ctx context.Context, conn *iam.Client, input *iam.
 	return output, nil
 }
 
+// findAttachedRolePoliciesByRoleName returns the managed policies attached to all roles in the account, keyed by role name.
+func findAttachedRolePoliciesByRoleName(ctx context.Context, conn *iam.Client) (map[string][]awstypes.AttachedPolicy, error) {
+	input := iam.GetAccountAuthorizationDetailsInput{
+		Filter: []awstypes.EntityType{awstypes.EntityTypeRole},
+	}
+	output := make(map[string][]awstypes.AttachedPolicy)
+
+	pages := iam.NewGetAccountAuthorizationDetailsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RoleDetailList {
+			output[aws.ToString(v.RoleName)] = v.AttachedManagedPolicies
+		}
+	}
+
+	return output, nil
+}
+
 func createRolePolicyAttachmentImportID(d *schema.ResourceData) string {
 	return (rolePolicyAttachmentImportID{}).Create(d)
 }
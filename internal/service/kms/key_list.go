@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -24,6 +25,10 @@ import (
 func newKeyResourceAsListResource() inttypes.ListResourceForSDK {
 	l := keyListResource{}
 	l.SetResourceSchema(resourceKey())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("key/"))
+	l.SetDisplayName(func(d *schema.ResourceData) string {
+		return d.Id()
+	})
 	return &l
 }
 
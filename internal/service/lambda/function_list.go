@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_lambda_function")
+func newFunctionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := functionListResource{}
+	l.SetResourceSchema(resourceFunction())
+	l.SetImportIDFromARN(framework.ImportIDFromARNResource("function:"))
+	l.SetDisplayName(func(d *schema.ResourceData) string {
+		return d.Get("function_name").(string)
+	})
+
+	return &l
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type functionListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var query functionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		for function, err := range listFunctions(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			functionName := aws.ToString(function.FunctionName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(functionName)
+			rd.Set("function_name", functionName)
+
+			tflog.Info(ctx, "Reading resource")
+			diags := resourceFunctionRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading Lambda Function", map[string]any{
+					"function_name": functionName,
+					"diags":         sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = functionName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listFunctions(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput) iter.Seq2[awstypes.FunctionConfiguration, error] {
+	return func(yield func(awstypes.FunctionConfiguration, error) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.FunctionConfiguration{}, fmt.Errorf("listing Lambda Functions: %w", err))
+				return
+			}
+
+			for _, function := range page.Functions {
+				if !yield(function, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -205,18 +205,27 @@ func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading Lambda Permission (%s/%s): %s", functionName, d.Id(), err)
 	}
 
+	if err := resourcePermissionFlatten(ctx, meta.(*conns.AWSClient), statement, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourcePermissionFlatten(ctx context.Context, c *conns.AWSClient, statement *policyStatement, d *schema.ResourceData) error {
+	functionName := d.Get("function_name").(string)
 	qualifier, _ := getQualifierFromAliasOrVersionARN(statement.Resource)
 	d.Set("qualifier", qualifier)
 
 	// Save Lambda function name in the same format
-	if strings.HasPrefix(functionName, "arn:"+meta.(*conns.AWSClient).Partition(ctx)+":lambda:") {
+	if strings.HasPrefix(functionName, "arn:"+c.Partition(ctx)+":lambda:") {
 		// Strip qualifier off
 		trimmed := strings.TrimSuffix(statement.Resource, ":"+qualifier)
 		d.Set("function_name", trimmed)
 	} else {
 		functionName, err := getFunctionNameFromARN(statement.Resource)
 		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
+			return err
 		}
 
 		d.Set("function_name", functionName)
@@ -252,7 +261,7 @@ func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("statement_id", statement.Sid)
 	d.Set("statement_id_prefix", create.NamePrefixFromName(statement.Sid))
 
-	return diags
+	return nil
 }
 
 func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
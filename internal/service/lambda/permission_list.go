@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_lambda_permission")
+func newPermissionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := permissionListResource{}
+	l.SetResourceSchema(resourcePermission())
+
+	return &l
+}
+
+var _ list.ListResourceWithRawV5Schemas = &permissionListResource{}
+
+type permissionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type permissionListResourceModel struct {
+	framework.WithRegionModel
+	FunctionName types.String `tfsdk:"function_name"`
+}
+
+func (l *permissionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"function_name": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *permissionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var query permissionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var functionNames iter.Seq2[string, error]
+		if v := query.FunctionName.ValueString(); v != "" {
+			functionNames = func(yield func(string, error) bool) {
+				yield(v, nil)
+			}
+		} else {
+			functionNames = func(yield func(string, error) bool) {
+				var input lambda.ListFunctionsInput
+				for function, err := range listFunctions(ctx, conn, &input) {
+					if !yield(aws.ToString(function.FunctionName), err) {
+						return
+					}
+				}
+			}
+		}
+
+		for functionName, err := range functionNames {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+			for statement, err := range listPolicyStatements(ctx, conn, functionName) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("statement_id"), statement.Sid)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(statement.Sid)
+				rd.Set("function_name", functionName)
+
+				tflog.Info(ctx, "Reading resource")
+				if err := resourcePermissionFlatten(ctx, awsClient, &statement, rd); err != nil {
+					result = fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = fmt.Sprintf("%s/%s", functionName, statement.Sid)
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listPolicyStatements returns the statements of the unqualified function's resource-based policy.
+func listPolicyStatements(ctx context.Context, conn *lambda.Client, functionName string) iter.Seq2[policyStatement, error] {
+	return func(yield func(policyStatement, error) bool) {
+		input := lambda.GetPolicyInput{
+			FunctionName: aws.String(functionName),
+		}
+		output, err := findPolicy(ctx, conn, &input)
+
+		if retry.NotFound(err) {
+			return
+		}
+
+		if err != nil {
+			yield(policyStatement{}, fmt.Errorf("reading Lambda Function (%s) policy: %w", functionName, err))
+			return
+		}
+
+		var v policy
+		if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), &v); err != nil {
+			yield(policyStatement{}, fmt.Errorf("reading Lambda Function (%s) policy: %w", functionName, err))
+			return
+		}
+
+		for _, statement := range v.Statement {
+			if !yield(statement, nil) {
+				return
+			}
+		}
+	}
+}
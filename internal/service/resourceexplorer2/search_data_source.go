@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -107,6 +108,21 @@ func (d *searchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Map each result to the corresponding Terraform resource type(s).
+	resources, diags := data.Resources.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range resources {
+		v.TerraformResourceTypes = flex.FlattenFrameworkStringValueListOfStringLegacy(ctx, tagpolicy.Lookup[v.ResourceType.ValueString()])
+	}
+	data.Resources, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, resources, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -125,13 +141,14 @@ type countData struct {
 }
 
 type resourcesData struct {
-	ARN             fwtypes.ARN                                     `tfsdk:"arn"`
-	LastReportedAt  timetypes.RFC3339                               `tfsdk:"last_reported_at"`
-	OwningAccountID types.String                                    `tfsdk:"owning_account_id"`
-	Properties      fwtypes.ListNestedObjectValueOf[propertiesData] `tfsdk:"properties"`
-	Region          types.String                                    `tfsdk:"region"`
-	ResourceType    types.String                                    `tfsdk:"resource_type"`
-	Service         types.String                                    `tfsdk:"service"`
+	ARN                    fwtypes.ARN                                     `tfsdk:"arn"`
+	LastReportedAt         timetypes.RFC3339                               `tfsdk:"last_reported_at"`
+	OwningAccountID        types.String                                    `tfsdk:"owning_account_id"`
+	Properties             fwtypes.ListNestedObjectValueOf[propertiesData] `tfsdk:"properties"`
+	Region                 types.String                                    `tfsdk:"region"`
+	ResourceType           types.String                                    `tfsdk:"resource_type"`
+	Service                types.String                                    `tfsdk:"service"`
+	TerraformResourceTypes fwtypes.ListOfString                            `tfsdk:"terraform_resource_types" autoflex:"-"`
 }
 
 type propertiesData struct {
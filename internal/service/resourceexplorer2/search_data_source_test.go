@@ -46,6 +46,7 @@ func testAccSearchDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.region"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.resource_type"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.service"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.terraform_resource_types.#"),
 				),
 			},
 		},
@@ -88,6 +89,7 @@ func testAccSearchDataSource_IndexType(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.region"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.resource_type"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.service"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.terraform_resource_types.#"),
 				),
 			},
 		},
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket_policy")
+func newBucketPolicyResourceAsListResource() inttypes.ListResourceForSDK {
+	l := bucketPolicyListResource{}
+	l.SetResourceSchema(resourceBucketPolicy())
+
+	return &l
+}
+
+type bucketPolicyListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type bucketPolicyListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *bucketPolicyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+
+	var query bucketPolicyListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(awsClient.Region(ctx)),
+		}
+		for bucket, err := range listBuckets(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			bucketName := aws.ToString(bucket.Name)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrBucket), bucketName)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(bucketName)
+
+			tflog.Info(ctx, "Reading resource")
+			diags := resourceBucketPolicyRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading S3 Bucket Policy", map[string]any{
+					names.AttrBucket: bucketName,
+					"diags":          sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			// The bucket has no policy.
+			if rd.Id() == "" {
+				continue
+			}
+
+			result.DisplayName = bucketName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_s3_bucket_policy" "test" {
+  count = 2
+
+  bucket = aws_s3_bucket.test[count.index].bucket
+  policy = data.aws_iam_policy_document.test[count.index].json
+}
+
+resource "aws_s3_bucket" "test" {
+  count = 2
+
+  bucket = "${var.rName}-${count.index}"
+}
+
+data "aws_iam_policy_document" "test" {
+  count = 2
+
+  statement {
+    effect = "Allow"
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+
+    actions = [
+      "s3:*",
+    ]
+
+    resources = [
+      aws_s3_bucket.test[count.index].arn,
+      "${aws_s3_bucket.test[count.index].arn}/*",
+    ]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
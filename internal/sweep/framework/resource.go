@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"unique"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,7 +18,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -47,73 +53,170 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, schema, err := sr.init(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, attr := range sr.attributes {
+		switch v := attr.value.(type) {
+		case *string:
+			ctx = tflog.SetField(ctx, attr.path, aws.ToString(v))
+
+		default:
+			ctx = tflog.SetField(ctx, attr.path, v)
+		}
+	}
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	return sr.withState(ctx, schema, func(state tfsdk.State) error {
+		return deleteResource(ctx, state, resource)
+	})
+}
+
+// Describe reads the resource and returns its type name, ID and tags.
+// A retry.NotFoundError is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (string, string, map[string]string, error) {
+	id := sr.id()
+	ctx = tflog.SetField(ctx, "id", id)
+
+	resource, schema, err := sr.init(ctx)
 	if err != nil {
+		return "", id, nil, err
+	}
+
+	var metadataResp fwresource.MetadataResponse
+	resource.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResp)
+	typeName := metadataResp.TypeName
+
+	ctx = describe.NewContext(ctx)
+	var state tfsdk.State
+	err = sr.withState(ctx, schema, func(v tfsdk.State) error {
+		var err error
+		state, err = readResource(ctx, v, resource)
 		return err
+	})
+	if err != nil {
+		return typeName, id, nil, err
+	}
+
+	if state.Raw.IsNull() {
+		return typeName, id, nil, &retry.NotFoundError{}
+	}
+
+	sp, v := describe.FrameworkResource(ctx, sr.meta, typeName)
+	var spTags unique.Handle[inttypes.ServicePackageResourceTags]
+	if v != nil {
+		spTags = v.Tags
+	}
+	tags, err := describe.Tags(ctx, sr.meta, sp, spTags, func(h interceptors.HTags) string {
+		return h.GetIdentifierFramework(ctx, state)
+	})
+	if err != nil {
+		return typeName, id, nil, err
+	}
+
+	return typeName, id, tags, nil
+}
+
+// id returns the identifier of the resource to be swept: the value of its "id" or "arn" attribute, or else of its first attribute.
+func (sr *sweepResource) id() string {
+	if len(sr.attributes) == 0 {
+		return ""
+	}
+
+	attr := sr.attributes[0]
+	for _, v := range sr.attributes {
+		if v.path == names.AttrID || v.path == names.AttrARN {
+			attr = v
+			break
+		}
+	}
+
+	switch v := attr.value.(type) {
+	case *string:
+		return aws.ToString(v)
+
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// init creates and configures the resource and returns it together with its schema.
+func (sr *sweepResource) init(ctx context.Context) (fwresource.ResourceWithConfigure, rschema.Schema, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, rschema.Schema{}, err
 	}
 
 	var configureResp fwresource.ConfigureResponse
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
 	if configureResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(configureResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(configureResp.Diagnostics)
 	}
 
 	var schemaResp fwresource.SchemaResponse
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 	if schemaResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
 	}
 
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		switch v := attr.value.(type) {
-		case *string:
-			ctx = tflog.SetField(ctx, attr.path, aws.ToString(v))
+	return resource, schemaResp.Schema, nil
+}
 
-		default:
-			ctx = tflog.SetField(ctx, attr.path, v)
-		}
+// withState calls f with a state containing the sweeper's attributes.
+func (sr *sweepResource) withState(ctx context.Context, schema rschema.Schema, f func(tfsdk.State) error) error {
+	state, err := sr.state(ctx, schema)
+	if err != nil {
+		return err
 	}
 
-	tflog.Info(ctx, "Sweeping resource")
-
-	err = deleteResource(ctx, state, resource)
+	err = f(state)
 
 	if errs.Contains(err, "Value Conversion Error") {
 		// Hack for per-resource Region override.
 		// Inject a top-level region attribute into the schema and retry.
-		schema := state.Schema.(rschema.Schema)
 		schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
 			Optional: true,
 			Computed: true,
 		}
-		state := tfsdk.State{
-			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
-			Schema: schema,
-		}
-		for _, attr := range sr.attributes {
-			d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-			if d.HasError() {
-				return fwdiag.DiagnosticsError(d)
-			}
+		state, err := sr.state(ctx, schema)
+		if err != nil {
+			return err
 		}
 
-		err = deleteResource(ctx, state, resource)
+		err = f(state)
 	}
 
 	return err
 }
 
+func (sr *sweepResource) state(ctx context.Context, schema rschema.Schema) (tfsdk.State, error) {
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return state, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
 
 	return fwdiag.DiagnosticsError(response.Diagnostics)
 }
+
+func readResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) (tfsdk.State, error) {
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	return response.State, fwdiag.DiagnosticsError(response.Diagnostics)
+}
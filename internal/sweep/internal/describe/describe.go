@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package describe contains helpers used by Sweepables to describe the resources that they delete.
+package describe
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type sdkResource struct {
+	servicePackage conns.ServicePackage
+	resource       *inttypes.ServicePackageSDKResource
+}
+
+type frameworkResource struct {
+	servicePackage conns.ServicePackage
+	resource       *inttypes.ServicePackageFrameworkResource
+}
+
+var registry struct {
+	once               sync.Once
+	sdkResources       map[uintptr]sdkResource // Read handler code pointer -> resource.
+	frameworkResources map[string]frameworkResource
+}
+
+func loadRegistry(ctx context.Context, meta *conns.AWSClient) {
+	registry.once.Do(func() {
+		registry.sdkResources = make(map[uintptr]sdkResource)
+		registry.frameworkResources = make(map[string]frameworkResource)
+
+		ambiguous := make(map[uintptr]struct{})
+		for sp := range meta.ServicePackages(ctx) {
+			for _, v := range sp.SDKResources(ctx) {
+				key := readHandler(v.Factory())
+				if key == 0 {
+					continue
+				}
+				// Resources that share a Read handler cannot be told apart.
+				if _, ok := registry.sdkResources[key]; ok {
+					ambiguous[key] = struct{}{}
+				}
+				registry.sdkResources[key] = sdkResource{servicePackage: sp, resource: v}
+			}
+
+			for _, v := range sp.FrameworkResources(ctx) {
+				registry.frameworkResources[v.TypeName] = frameworkResource{servicePackage: sp, resource: v}
+			}
+		}
+
+		for key := range ambiguous {
+			delete(registry.sdkResources, key)
+		}
+	})
+}
+
+// readHandler returns the code pointer of the resource's Read handler.
+// Resource factories return a new *schema.Resource on each call, but the handlers are the same functions.
+func readHandler(r *schema.Resource) uintptr {
+	for _, f := range []any{r.ReadWithoutTimeout, r.ReadContext, r.Read} {
+		if v := reflect.ValueOf(f); !v.IsNil() {
+			return v.Pointer()
+		}
+	}
+
+	return 0
+}
+
+// SDKResource returns the service package and registration of the specified Plugin SDK resource.
+// nil values are returned if the resource cannot be found.
+func SDKResource(ctx context.Context, meta *conns.AWSClient, r *schema.Resource) (conns.ServicePackage, *inttypes.ServicePackageSDKResource) {
+	loadRegistry(ctx, meta)
+
+	if v, ok := registry.sdkResources[readHandler(r)]; ok {
+		return v.servicePackage, v.resource
+	}
+
+	return nil, nil
+}
+
+// FrameworkResource returns the service package and registration of the Plugin Framework resource with the specified type name.
+// nil values are returned if the resource cannot be found.
+func FrameworkResource(ctx context.Context, meta *conns.AWSClient, typeName string) (conns.ServicePackage, *inttypes.ServicePackageFrameworkResource) {
+	loadRegistry(ctx, meta)
+
+	if v, ok := registry.frameworkResources[typeName]; ok {
+		return v.servicePackage, v.resource
+	}
+
+	return nil, nil
+}
+
+// NewContext returns a Context that captures the tags set by a resource's Read handler.
+func NewContext(ctx context.Context) context.Context {
+	return tftags.NewContext(ctx, nil, nil, nil)
+}
+
+// Tags returns a resource's tags after its Read handler has been called.
+// If the Read handler didn't set tags, they are read using the service package's tagging API.
+// identifier returns the value of the resource's tagging identifier attribute.
+func Tags(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage, tags unique.Handle[inttypes.ServicePackageResourceTags], identifier func(interceptors.HTags) string) (map[string]string, error) {
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	if h := interceptors.HTags(tags); inContext.TagsOut.IsNone() && sp != nil && h.Enabled() {
+		if identifier := identifier(h); identifier != "" {
+			if err := h.ListTags(ctx, sp, meta, identifier); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if inContext.TagsOut.IsNone() {
+		return nil, nil
+	}
+
+	return inContext.TagsOut.UnwrapOrDefault().Map(), nil
+}
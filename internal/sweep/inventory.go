@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// InventoryItem describes a resource that a sweeper would delete.
+type InventoryItem struct {
+	ResourceType string            `json:"resource_type"`
+	ID           string            `json:"id"`
+	Region       string            `json:"region"`
+	Tags         map[string]string `json:"tags"`
+	Sweeper      string            `json:"sweeper"`
+	// Error is set if the resource could not be described.
+	Error string `json:"error,omitempty"`
+}
+
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+type Describer interface {
+	// Describe returns the resource's type name, ID and tags.
+	// A retry.NotFoundError is returned if the resource no longer exists.
+	Describe(ctx context.Context) (string, string, map[string]string, error)
+}
+
+// TagFilter selects the resources to sweep by tag.
+// Each condition is either a tag key, matching any value, or a key=value pair.
+type TagFilter struct {
+	// Include, if not empty, selects only resources with at least one matching tag.
+	Include []string
+	// Exclude rejects resources with any matching tag, e.g. "keep=true".
+	Exclude []string
+}
+
+// ParseTagFilter returns a TagFilter from comma-separated lists of conditions.
+func ParseTagFilter(include, exclude string) TagFilter {
+	split := func(s string) []string {
+		var v []string
+		for s := range strings.SplitSeq(s, ",") {
+			if s := strings.TrimSpace(s); s != "" {
+				v = append(v, s)
+			}
+		}
+		return v
+	}
+
+	return TagFilter{
+		Include: split(include),
+		Exclude: split(exclude),
+	}
+}
+
+func (f TagFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Match returns whether a resource with the specified tags is selected by the filter.
+func (f TagFilter) Match(tags map[string]string) bool {
+	match := func(condition string) bool {
+		key, value, ok := strings.Cut(condition, "=")
+		v, exists := tags[key]
+		return exists && (!ok || v == value)
+	}
+
+	if slices.ContainsFunc(f.Exclude, match) {
+		return false
+	}
+
+	return len(f.Include) == 0 || slices.ContainsFunc(f.Include, match)
+}
+
+// sweepRun holds the settings of the current RunSweepers call that are used by SweepOrchestrator
+// and SharedRegionalSweepClient, which are called from sweeper functions.
+var sweepRun struct {
+	lock      sync.Mutex
+	dryRun    bool
+	tagFilter TagFilter
+	// In a dry run, or with a tag filter, sweepers run one at a time, so these identify the running sweeper.
+	region    string
+	sweeper   string
+	inventory []InventoryItem
+	// refused is set if the running sweeper called an AWS API that may modify resources without using SweepOrchestrator.
+	refused bool
+}
+
+func startSweepRun(opts RunSweepersOptions) {
+	sweepRun.lock.Lock()
+	defer sweepRun.lock.Unlock()
+
+	sweepRun.dryRun = opts.DryRun
+	sweepRun.tagFilter = opts.TagFilter
+}
+
+func endSweepRun() {
+	startSweepRun(RunSweepersOptions{})
+}
+
+// isGuarded returns whether AWS API calls that may modify resources are restricted, in a dry run or with a tag filter.
+func isGuarded() bool {
+	sweepRun.lock.Lock()
+	defer sweepRun.lock.Unlock()
+
+	return sweepRun.dryRun || !sweepRun.tagFilter.IsEmpty()
+}
+
+// startSweeper records the sweeper that is about to run in a dry run or with a tag filter.
+func startSweeper(region, name string) {
+	sweepRun.lock.Lock()
+	defer sweepRun.lock.Unlock()
+
+	sweepRun.region, sweepRun.sweeper, sweepRun.inventory, sweepRun.refused = region, name, nil, false
+}
+
+// endSweeper returns the inventory recorded by the sweeper that has just run in a dry run,
+// and whether the sweeper was refused because it does not support tag filters.
+func endSweeper() ([]InventoryItem, bool) {
+	sweepRun.lock.Lock()
+	defer sweepRun.lock.Unlock()
+
+	inventory, refused := sweepRun.inventory, sweepRun.refused
+	sweepRun.region, sweepRun.sweeper, sweepRun.inventory, sweepRun.refused = "", "", nil, false
+
+	return inventory, refused
+}
+
+type sweepOrchestratorContextKeyType int
+
+var sweepOrchestratorContextKey sweepOrchestratorContextKeyType
+
+// newSweepOrchestratorContext returns a Context recording that resources selected by SweepOrchestrator are being deleted.
+func newSweepOrchestratorContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sweepOrchestratorContextKey, true)
+}
+
+func isSweepOrchestratorContext(ctx context.Context) bool {
+	v, _ := ctx.Value(sweepOrchestratorContextKey).(bool)
+	return v
+}
+
+// filterSweepables describes the sweepables and returns those selected by the current tag filter.
+// In a dry run the selected resources are recorded in the running sweeper's inventory.
+func filterSweepables(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	sweepRun.lock.Lock()
+	dryRun, tagFilter, region, sweeper := sweepRun.dryRun, sweepRun.tagFilter, sweepRun.region, sweepRun.sweeper
+	sweepRun.lock.Unlock()
+
+	if !dryRun && tagFilter.IsEmpty() {
+		return sweepables, nil
+	}
+
+	items := make([]*InventoryItem, len(sweepables))
+	var g tfsync.Group
+	for i, sweepable := range sweepables {
+		g.Go(ctx, func(ctx context.Context) error {
+			item := InventoryItem{
+				Region:  region,
+				Sweeper: sweeper,
+			}
+
+			v, ok := sweepable.(Describer)
+			if !ok {
+				item.Error = fmt.Sprintf("%T cannot be described", sweepable)
+				if tagFilter.IsEmpty() {
+					items[i] = &item
+				}
+				return nil
+			}
+
+			var err error
+			item.ResourceType, item.ID, item.Tags, err = v.Describe(ctx)
+			ctx = tflog.SetField(ctx, "id", item.ID)
+
+			switch {
+			case retry.NotFound(err):
+				tflog.Debug(ctx, "Resource no longer exists")
+			case err != nil:
+				item.Error = err.Error()
+				if tagFilter.IsEmpty() {
+					items[i] = &item
+				} else {
+					tflog.Warn(ctx, "Skipping resource: its tags could not be read", map[string]any{
+						"error": err.Error(),
+					})
+				}
+			case !tagFilter.Match(item.Tags):
+				tflog.Info(ctx, "Skipping resource: excluded by tag filter")
+			default:
+				items[i] = &item
+			}
+
+			return nil
+		})
+	}
+	if err := g.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	var selected []Sweepable
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+
+		if dryRun {
+			sweepRun.lock.Lock()
+			sweepRun.inventory = append(sweepRun.inventory, *item)
+			sweepRun.lock.Unlock()
+		} else {
+			selected = append(selected, sweepables[i])
+		}
+	}
+
+	return selected, nil
+}
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// guardMiddleware fails AWS API calls that may modify resources when they are not allowed by the current sweep run.
+// In a dry run it protects against sweepers that delete resources without using SweepOrchestrator.
+// With a tag filter only calls made by SweepOrchestrator, which checks each resource's tags, are allowed.
+// Service metadata, e.g. the operation name, is only available to middleware after the Initialize step.
+func guardMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("SweeperGuard", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		service, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		if slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(operation, prefix)
+		}) {
+			return next.HandleFinalize(ctx, in)
+		}
+
+		sweepRun.lock.Lock()
+		dryRun, tagFilter := sweepRun.dryRun, sweepRun.tagFilter
+		refused := !dryRun && !tagFilter.IsEmpty() && !isSweepOrchestratorContext(ctx)
+		if refused {
+			sweepRun.refused = true
+		}
+		sweepRun.lock.Unlock()
+
+		switch {
+		case dryRun:
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("dry run: %s %s is not a read-only operation", service, operation)
+		case refused:
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("tag filter: %s %s was not called by SweepOrchestrator, so the resource's tags were not checked", service, operation)
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), middleware.Before)
+}
+
+// WriteInventory writes the inventory of a dry run, in Region order, as JSON.
+func WriteInventory(w io.Writer, regions []string, results map[string][]SweeperResult) error {
+	inventory := make([]InventoryItem, 0)
+	for _, region := range regions {
+		for _, v := range results[strings.TrimSpace(region)] {
+			inventory = append(inventory, v.Inventory...)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(inventory)
+}
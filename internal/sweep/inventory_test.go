@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestTagFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		include, exclude string
+		tags             map[string]string
+		expected         bool
+	}{
+		"empty filter": {
+			tags:     map[string]string{"Name": "test"},
+			expected: true,
+		},
+		"excluded key value": {
+			exclude:  "keep=true",
+			tags:     map[string]string{"keep": "true"},
+			expected: false,
+		},
+		"excluded key value different value": {
+			exclude:  "keep=true",
+			tags:     map[string]string{"keep": "false"},
+			expected: true,
+		},
+		"excluded key": {
+			exclude:  "owner, keep=true",
+			tags:     map[string]string{"owner": "team"},
+			expected: false,
+		},
+		"included": {
+			include:  "created-by=tf-acc-test",
+			tags:     map[string]string{"created-by": "tf-acc-test"},
+			expected: true,
+		},
+		"not included": {
+			include:  "created-by=tf-acc-test",
+			tags:     map[string]string{"Name": "test"},
+			expected: false,
+		},
+		"not included no tags": {
+			include:  "created-by",
+			expected: false,
+		},
+		"included and excluded": {
+			include:  "created-by",
+			exclude:  "keep=true",
+			tags:     map[string]string{"created-by": "tf-acc-test", "keep": "true"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ParseTagFilter(testCase.include, testCase.exclude).Match(testCase.tags), testCase.expected; got != want {
+				t.Errorf("Match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+type testSweepable struct {
+	id      string
+	tags    map[string]string
+	err     error
+	lock    *sync.Mutex
+	deleted *[]string
+}
+
+func (s testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	*s.deleted = append(*s.deleted, s.id)
+	return nil
+}
+
+func (s testSweepable) Describe(context.Context) (string, string, map[string]string, error) {
+	return "aws_test", s.id, s.tags, s.err
+}
+
+type testNotDescribable struct {
+	sweepable testSweepable
+}
+
+func (s testNotDescribable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	return s.sweepable.Delete(ctx, optFns...)
+}
+
+// The tests below modify package state, so must not be run in parallel.
+
+func TestSweepOrchestratorDryRun(t *testing.T) { //nolint:paralleltest // Modifies package state
+	ctx := t.Context()
+
+	var lock sync.Mutex
+	var deleted []string
+	sweepables := []Sweepable{
+		testSweepable{id: "a", tags: map[string]string{"Name": "a"}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "b", tags: map[string]string{"keep": "true"}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "c", err: &retry.NotFoundError{}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "d", err: errors.New("AccessDenied"), lock: &lock, deleted: &deleted},
+	}
+
+	startSweepRun(RunSweepersOptions{DryRun: true, TagFilter: ParseTagFilter("", "keep=true")})
+	t.Cleanup(endSweepRun)
+
+	startSweeper("us-west-2", "aws_test") //lintignore:AWSAT003
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatal(err)
+	}
+	inventory, _ := endSweeper()
+
+	if len(deleted) != 0 {
+		t.Errorf("deleted %v in a dry run", deleted)
+	}
+
+	expected := []InventoryItem{
+		{
+			ResourceType: "aws_test",
+			ID:           "a",
+			Region:       "us-west-2", //lintignore:AWSAT003
+			Tags:         map[string]string{"Name": "a"},
+			Sweeper:      "aws_test",
+		},
+	}
+	if diff := cmp.Diff(inventory, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorTagFilter(t *testing.T) { //nolint:paralleltest // Modifies package state
+	ctx := t.Context()
+
+	var lock sync.Mutex
+	var deleted []string
+	sweepables := []Sweepable{
+		testSweepable{id: "a", tags: map[string]string{"Name": "a"}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "b", tags: map[string]string{"keep": "true"}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "c", err: &retry.NotFoundError{}, lock: &lock, deleted: &deleted},
+		testSweepable{id: "d", err: errors.New("AccessDenied"), lock: &lock, deleted: &deleted},
+		testNotDescribable{sweepable: testSweepable{id: "e", lock: &lock, deleted: &deleted}},
+	}
+
+	startSweepRun(RunSweepersOptions{TagFilter: ParseTagFilter("", "keep=true")})
+	t.Cleanup(endSweepRun)
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(deleted)
+	if diff := cmp.Diff(deleted, []string{"a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+// testAPISweepable calls an AWS API that modifies resources when it is deleted.
+type testAPISweepable struct {
+	testSweepable
+	conn *sts.Client
+}
+
+func (s testAPISweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	if _, err := s.conn.AssumeRole(ctx, &sts.AssumeRoleInput{RoleArn: aws.String("arn:aws:iam::123456789012:role/" + s.id), RoleSessionName: aws.String("test")}); err != nil { //lintignore:AWSAT005
+		return err
+	}
+
+	return s.testSweepable.Delete(ctx, optFns...)
+}
+
+// testGuardedSTSClient returns an STS API client, sending requests to a local test server, with guardMiddleware added.
+// The names of the operations received by the server are returned.
+func testGuardedSTSClient(t *testing.T) (*sts.Client, func() []string) {
+	t.Helper()
+
+	var lock sync.Mutex
+	var operations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing request: %s", err)
+		}
+		operation := r.Form.Get("Action")
+
+		lock.Lock()
+		operations = append(operations, operation)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte("<" + operation + "Response><" + operation + "Result></" + operation + "Result></" + operation + "Response>"))
+	}))
+	t.Cleanup(server.Close)
+
+	conn := sts.NewFromConfig(aws.Config{
+		APIOptions:  []func(*middleware.Stack) error{guardMiddleware},
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:      "us-west-2", //lintignore:AWSAT003
+	}, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	return conn, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return slices.Clone(operations)
+	}
+}
+
+func TestGuardMiddlewareDryRun(t *testing.T) { //nolint:paralleltest // Modifies package state
+	ctx := t.Context()
+	conn, operations := testGuardedSTSClient(t)
+
+	startSweepRun(RunSweepersOptions{DryRun: true})
+	t.Cleanup(endSweepRun)
+
+	if _, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Errorf("GetCallerIdentity: %s", err)
+	}
+
+	// No API calls that may modify resources are allowed, even while deleting resources selected by SweepOrchestrator.
+	for _, ctx := range []context.Context{ctx, newSweepOrchestratorContext(ctx)} {
+		if _, err := conn.AssumeRole(ctx, &sts.AssumeRoleInput{RoleArn: aws.String("arn:aws:iam::123456789012:role/test"), RoleSessionName: aws.String("test")}); err == nil { //lintignore:AWSAT005
+			t.Error("AssumeRole: expected error in a dry run")
+		}
+	}
+
+	if diff := cmp.Diff(operations(), []string{"GetCallerIdentity"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRunSweepersInRegion_TagFilter(t *testing.T) { //nolint:paralleltest // Modifies package state
+	ctx := t.Context()
+	conn, operations := testGuardedSTSClient(t)
+
+	opts := RunSweepersOptions{
+		Parallelism: 3,
+		TagFilter:   ParseTagFilter("", "keep=true"),
+	}
+	startSweepRun(opts)
+	t.Cleanup(endSweepRun)
+
+	var lock sync.Mutex
+	var deleted []string
+	sweepers := testSweepers(map[string][]string{
+		"aws_direct":       nil,
+		"aws_orchestrated": nil,
+		"aws_parent":       {"aws_direct"},
+	}, func(name string) error {
+		switch name {
+		case "aws_direct":
+			// Deletes a resource without checking its tags.
+			_, err := conn.AssumeRole(ctx, &sts.AssumeRoleInput{RoleArn: aws.String("arn:aws:iam::123456789012:role/direct"), RoleSessionName: aws.String("test")}) //lintignore:AWSAT005
+			return err
+		case "aws_orchestrated":
+			return SweepOrchestrator(ctx, []Sweepable{
+				testAPISweepable{testSweepable: testSweepable{id: "a", tags: map[string]string{"Name": "a"}, lock: &lock, deleted: &deleted}, conn: conn},
+				testAPISweepable{testSweepable: testSweepable{id: "b", tags: map[string]string{"keep": "true"}, lock: &lock, deleted: &deleted}, conn: conn},
+			})
+		}
+		return nil
+	})
+
+	graph, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := filterSweepers(graph, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runSweepersInRegion("us-west-2", sweepers, graph, names, opts) //lintignore:AWSAT003
+
+	got := make(map[string]SweeperStatus)
+	for _, v := range results {
+		got[v.Name] = v.Status
+	}
+	expected := map[string]SweeperStatus{
+		"aws_direct":       SweeperStatusSkipped,
+		"aws_orchestrated": SweeperStatusDeleted,
+		"aws_parent":       SweeperStatusSkipped,
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(deleted, []string{"a"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(operations(), []string{"AssumeRole"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+)
+
+// sweepers holds all registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// The sweeper is also registered with the testing framework so that it can be run by resource.TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+	resource.AddTestSweepers(name, s)
+}
+
+type SweeperStatus string
+
+const (
+	SweeperStatusDeleted SweeperStatus = "deleted"
+	SweeperStatusFailed  SweeperStatus = "failed"
+	SweeperStatusListed  SweeperStatus = "listed"
+	SweeperStatusSkipped SweeperStatus = "skipped"
+)
+
+// SweeperResult is the outcome of running a single sweeper in a Region.
+type SweeperResult struct {
+	Name     string
+	Status   SweeperStatus
+	Duration time.Duration
+	Err      error
+	// Inventory holds the resources that the sweeper would delete, for a dry run.
+	Inventory []InventoryItem
+}
+
+// RunSweepersOptions configures RunSweepers.
+type RunSweepersOptions struct {
+	// AllowFailures runs a sweeper even if one of its dependencies failed.
+	AllowFailures bool
+	// DryRun lists the resources that each sweeper would delete, without deleting anything.
+	// Sweepers are run one at a time and all AWS API calls that may modify resources fail.
+	DryRun bool
+	// Filter is a comma-separated list of sweeper name substrings. Empty means all sweepers.
+	Filter string
+	// Parallelism is the maximum number of sweepers run concurrently in a Region.
+	Parallelism int
+	// TagFilter selects the resources to sweep by tag.
+	// Sweepers are run one at a time and sweepers that modify resources without using SweepOrchestrator are skipped.
+	TagFilter TagFilter
+}
+
+// RunSweepers runs the registered sweepers in each Region in turn.
+// Sweepers are ordered using a dependency graph built from their declared dependencies:
+// a sweeper runs only once all of its dependencies have completed, and sweepers in
+// independent branches of the graph run concurrently.
+// The per-sweeper results for each Region are returned.
+func RunSweepers(regions []string, opts RunSweepersOptions) (map[string][]SweeperResult, error) {
+	graph, err := sweeperGraph(sweepers)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := filterSweepers(graph, opts.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	startSweepRun(opts)
+	defer endSweepRun()
+
+	var failed bool
+	results := make(map[string][]SweeperResult, len(regions))
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+		regionResults := runSweepersInRegion(region, sweepers, graph, names, opts)
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		logSweeperSummary(region, regionResults, opts.DryRun)
+
+		for _, v := range regionResults {
+			if v.Status == SweeperStatusFailed {
+				failed = true
+			}
+		}
+		results[region] = regionResults
+
+		if failed && !opts.AllowFailures {
+			break
+		}
+	}
+
+	if failed {
+		return results, errors.New("at least one sweeper failed")
+	}
+
+	return results, nil
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// An error is returned if a dependency is not registered or there is a dependency cycle.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	graph := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(sweepers))
+	for _, name := range names {
+		graph.AddNode(name)
+	}
+
+	var errs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := graph.AddDependency(name, dependency); err != nil {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if _, err := graph.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	return graph, nil
+}
+
+// filterSweepers returns the names of the sweepers matching the filter, together with all of their dependencies, in dependency order.
+func filterSweepers(graph *depgraph.Graph, filter string) ([]string, error) {
+	order, err := graph.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == "" {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range order {
+		for v := range strings.SplitSeq(strings.ToLower(filter), ",") {
+			if v == "" || !strings.Contains(strings.ToLower(name), v) {
+				continue
+			}
+
+			selected[name] = struct{}{}
+			dependencies, err := graph.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+			for _, dependency := range dependencies {
+				selected[dependency] = struct{}{}
+			}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
+
+func runSweepersInRegion(region string, sweepers map[string]*resource.Sweeper, graph *depgraph.Graph, names []string, opts RunSweepersOptions) []SweeperResult {
+	parallelism := max(opts.Parallelism, 1)
+	// Sweepers that don't support tag filters are detected while they run, so must run one at a time.
+	sequential := opts.DryRun || !opts.TagFilter.IsEmpty()
+	if sequential {
+		parallelism = 1
+	}
+
+	done := make(map[string]chan struct{}, len(names))
+	for _, name := range names {
+		done[name] = make(chan struct{})
+	}
+
+	var lock sync.Mutex
+	statuses := make(map[string]SweeperStatus, len(names))
+	results := make([]SweeperResult, len(names))
+	semaphore := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Go(func() {
+			defer close(done[name])
+
+			dependencies, _ := graph.DirectDependenciesOf(name)
+			var skip bool
+			for _, dependency := range dependencies {
+				<-done[dependency]
+
+				lock.Lock()
+				status := statuses[dependency]
+				lock.Unlock()
+
+				if status != SweeperStatusDeleted && status != SweeperStatusListed && !opts.AllowFailures {
+					skip = true
+				}
+			}
+
+			result := SweeperResult{Name: name}
+			if skip {
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): a dependency did not complete", name, region)
+				result.Status = SweeperStatusSkipped
+			} else {
+				semaphore <- struct{}{}
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+				start := time.Now()
+				if sequential {
+					startSweeper(region, name)
+				}
+				result.Err = sweepers[name].F(region)
+				var refused bool
+				if sequential {
+					result.Inventory, refused = endSweeper()
+				}
+				result.Duration = time.Since(start)
+				<-semaphore
+
+				switch {
+				case refused:
+					log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): it modifies resources without using SweepOrchestrator, so does not support tag filters", name, region)
+					result.Status = SweeperStatusSkipped
+					result.Err = nil
+				case result.Err != nil:
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, result.Err)
+					result.Status = SweeperStatusFailed
+				default:
+					log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, result.Duration)
+					result.Status = SweeperStatusDeleted
+					if opts.DryRun {
+						result.Status = SweeperStatusListed
+					}
+				}
+			}
+
+			lock.Lock()
+			statuses[name] = result.Status
+			results[i] = result
+			lock.Unlock()
+		})
+	}
+	wg.Wait()
+
+	return results
+}
+
+func logSweeperSummary(region string, results []SweeperResult, dryRun bool) {
+	counts := make(map[SweeperStatus]int)
+	for _, v := range results {
+		counts[v.Status]++
+	}
+
+	if dryRun {
+		log.Printf("Sweeper summary for region (%s) (dry run): %d listed, %d skipped, %d failed", region, counts[SweeperStatusListed], counts[SweeperStatusSkipped], counts[SweeperStatusFailed])
+	} else {
+		log.Printf("Sweeper summary for region (%s): %d deleted, %d skipped, %d failed", region, counts[SweeperStatusDeleted], counts[SweeperStatusSkipped], counts[SweeperStatusFailed])
+	}
+	for _, v := range results {
+		switch v.Status {
+		case SweeperStatusFailed:
+			log.Printf("\t- %s: %s (%s): %s", v.Name, v.Status, v.Duration.Round(time.Millisecond), v.Err)
+		case SweeperStatusListed:
+			log.Printf("\t- %s: %s %d resources (%s)", v.Name, v.Status, len(v.Inventory), v.Duration.Round(time.Millisecond))
+		case SweeperStatusSkipped:
+			log.Printf("\t- %s: %s", v.Name, v.Status)
+		default:
+			log.Printf("\t- %s: %s (%s)", v.Name, v.Status, v.Duration.Round(time.Millisecond))
+		}
+	}
+}
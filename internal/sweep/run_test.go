@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers    map[string][]string
+		expectError bool
+	}{
+		"no dependencies": {
+			sweepers: map[string][]string{
+				"aws_a": nil,
+				"aws_b": nil,
+			},
+		},
+		"dependencies": {
+			sweepers: map[string][]string{
+				"aws_vpc":      {"aws_subnet"},
+				"aws_subnet":   {"aws_instance"},
+				"aws_instance": nil,
+			},
+		},
+		"missing dependency": {
+			sweepers: map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			},
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeperGraph(testSweepers(testCase.sweepers, nil))
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("sweeperGraph error: got %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	graph, err := sweeperGraph(testSweepers(map[string][]string{
+		"aws_vpc":            {"aws_subnet", "aws_security_group"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_security_group": {"aws_instance"},
+		"aws_s3_bucket":      nil,
+	}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"aws_s3_bucket", "aws_instance", "aws_subnet", "aws_security_group", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter:   "aws_subnet",
+			expected: []string{"aws_instance", "aws_subnet"},
+		},
+		"multiple": {
+			filter:   "S3,security",
+			expected: []string{"aws_s3_bucket", "aws_instance", "aws_security_group"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterSweepers(graph, testCase.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepersInRegion(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_vpc":            {"aws_subnet", "aws_security_group"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_security_group": {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_s3_bucket":      nil,
+	}
+
+	testCases := map[string]struct {
+		failures      []string
+		allowFailures bool
+		expected      map[string]SweeperStatus
+	}{
+		"success": {
+			expected: map[string]SweeperStatus{
+				"aws_instance":       SweeperStatusDeleted,
+				"aws_s3_bucket":      SweeperStatusDeleted,
+				"aws_security_group": SweeperStatusDeleted,
+				"aws_subnet":         SweeperStatusDeleted,
+				"aws_vpc":            SweeperStatusDeleted,
+			},
+		},
+		"failure": {
+			failures: []string{"aws_subnet"},
+			expected: map[string]SweeperStatus{
+				"aws_instance":       SweeperStatusDeleted,
+				"aws_s3_bucket":      SweeperStatusDeleted,
+				"aws_security_group": SweeperStatusDeleted,
+				"aws_subnet":         SweeperStatusFailed,
+				"aws_vpc":            SweeperStatusSkipped,
+			},
+		},
+		"failure allowed": {
+			failures:      []string{"aws_instance"},
+			allowFailures: true,
+			expected: map[string]SweeperStatus{
+				"aws_instance":       SweeperStatusFailed,
+				"aws_s3_bucket":      SweeperStatusDeleted,
+				"aws_security_group": SweeperStatusDeleted,
+				"aws_subnet":         SweeperStatusDeleted,
+				"aws_vpc":            SweeperStatusDeleted,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var order []string
+			sweepers := testSweepers(dependencies, func(name string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				order = append(order, name)
+				for _, v := range testCase.failures {
+					if v == name {
+						return errors.New("failed")
+					}
+				}
+				return nil
+			})
+
+			graph, err := sweeperGraph(sweepers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			names, err := filterSweepers(graph, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			results := runSweepersInRegion("us-west-2", sweepers, graph, names, RunSweepersOptions{ //lintignore:AWSAT003
+				AllowFailures: testCase.allowFailures,
+				Parallelism:   3,
+			})
+
+			got := make(map[string]SweeperStatus)
+			for _, v := range results {
+				got[v.Name] = v.Status
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			// Every sweeper that ran must have run after its dependencies.
+			position := make(map[string]int)
+			for i, v := range order {
+				position[v] = i
+			}
+			for name, i := range position {
+				for _, dependency := range dependencies[name] {
+					if j, ok := position[dependency]; !ok || j > i {
+						t.Errorf("sweeper %s ran before its dependency %s", name, dependency)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestRunSweepersInRegion_SharedClient verifies that sweepers running in parallel share a single regional client.
+// Run with -race to detect unsynchronized access to the client cache.
+func TestRunSweepersInRegion_SharedClient(t *testing.T) { //nolint:paralleltest // Overrides newSweepClient
+	ctx := t.Context()
+	const region = "us-east-2" //lintignore:AWSAT003
+
+	var calls atomic.Int32
+	newSweepClient = func(context.Context, string) (*conns.AWSClient, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return new(conns.AWSClient), nil
+	}
+	t.Cleanup(func() {
+		newSweepClient = newRegionalSweepClient
+
+		sweeperClientsLock.Lock()
+		delete(sweeperClients, region)
+		sweeperClientsLock.Unlock()
+	})
+
+	var lock sync.Mutex
+	var clients []*conns.AWSClient
+	sweepers := testSweepers(map[string][]string{
+		"aws_a": nil,
+		"aws_b": nil,
+	}, func(name string) error {
+		client, err := SharedRegionalSweepClient(ctx, region)
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		clients = append(clients, client)
+
+		return nil
+	})
+
+	graph, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := filterSweepers(graph, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := runSweepersInRegion(region, sweepers, graph, names, RunSweepersOptions{
+		Parallelism: 2,
+	})
+
+	for _, v := range results {
+		if v.Err != nil {
+			t.Errorf("sweeper %s: %s", v.Name, v.Err)
+		}
+	}
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("client created %d times, want %d", got, want)
+	}
+	if len(clients) != 2 || clients[0] != clients[1] {
+		t.Errorf("expected both sweepers to share a client, got %v", clients)
+	}
+}
+
+func testSweepers(dependencies map[string][]string, f func(string) error) map[string]*resource.Sweeper {
+	sweepers := make(map[string]*resource.Sweeper, len(dependencies))
+
+	for name, dependencies := range dependencies {
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(string) error {
+				if f == nil {
+					return nil
+				}
+				return f(name)
+			},
+			Dependencies: dependencies,
+		}
+	}
+
+	return sweepers
+}
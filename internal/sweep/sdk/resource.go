@@ -5,13 +5,19 @@ package sdk
 
 import (
 	"context"
+	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +45,57 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Describe reads the resource and returns its type name, ID and tags.
+// A retry.NotFoundError is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (string, string, map[string]string, error) {
+	id := sr.d.Id()
+	ctx = tflog.SetField(ctx, "id", id)
+
+	sp, v := describe.SDKResource(ctx, sr.meta, sr.resource)
+	var typeName string
+	if v != nil {
+		typeName = v.TypeName
+	}
+
+	ctx = describe.NewContext(ctx)
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return typeName, id, nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return typeName, id, nil, &retry.NotFoundError{}
+	}
+
+	var spTags unique.Handle[inttypes.ServicePackageResourceTags]
+	if v != nil {
+		spTags = v.Tags
+	}
+	tags, err := describe.Tags(ctx, sr.meta, sp, spTags, func(h interceptors.HTags) string {
+		return h.GetIdentifierSDKv2(ctx, sr.d)
+	})
+	if err != nil {
+		return typeName, id, nil, err
+	}
+
+	// Fall back to any tags set directly by the Read handler.
+	if tags == nil {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if _, ok := sr.resource.SchemaMap()[k]; !ok {
+				continue
+			}
+			if v, ok := sr.d.Get(k).(map[string]any); ok && len(v) > 0 {
+				tags = make(map[string]string, len(v))
+				for k, v := range v {
+					tags[k], _ = v.(string)
+				}
+				break
+			}
+		}
+	}
+
+	return typeName, id, tags, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
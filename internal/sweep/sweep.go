@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -29,14 +30,35 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// Sweepers run concurrently, so access is guarded by sweeperClientsLock.
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
+
+// newSweepClient is overridden in tests.
+var newSweepClient = newRegionalSweepClient
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
 
+	client, err := newSweepClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	sweeperClients[region] = client
+
+	return client, nil
+}
+
+func newRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
 	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -88,6 +110,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		conf.AssumeRole = []awsbase.AssumeRole{ar}
 	}
 
+	if isGuarded() {
+		conf.APIOptions = append(conf.APIOptions, guardMiddleware)
+	}
+
 	// configures a default client for the region, using the above env vars
 	client, diags := conf.ConfigureProvider(ctx, meta)
 
@@ -95,8 +121,6 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -109,8 +133,14 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	sweepables, err := filterSweepables(ctx, sweepables)
+	if err != nil {
+		return err
+	}
+
 	var g tfsync.Group
 
+	ctx = newSweepOrchestratorContext(ctx)
 	for _, sweepable := range sweepables {
 		g.Go(ctx, func(ctx context.Context) error {
 			return sweepable.Delete(ctx, optFns...)
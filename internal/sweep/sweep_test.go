@@ -5,12 +5,24 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "list the resources that would be swept without deleting them")
+	flagSweepExcludeTags = flag.String("sweep-exclude-tags", "", "comma-separated list of tag keys or key=value pairs; resources with any matching tag are not swept")
+	flagSweepIncludeTags = flag.String("sweep-include-tags", "", "comma-separated list of tag keys or key=value pairs; only resources with a matching tag are swept")
+	flagSweepInventory   = flag.String("sweep-inventory", "", "file to write the JSON inventory of a dry run to, default standard output")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 1, "maximum number of sweepers to run concurrently in each region")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +30,46 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the testing framework.
+	flag.Parse()
+	if v := flag.Lookup("sweep").Value.String(); v != "" {
+		opts := sweep.RunSweepersOptions{
+			AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+			DryRun:        *flagSweepDryRun,
+			Filter:        flag.Lookup("sweep-run").Value.String(),
+			Parallelism:   *flagSweepParallelism,
+			TagFilter:     sweep.ParseTagFilter(*flagSweepIncludeTags, *flagSweepExcludeTags),
+		}
+
+		regions := strings.Split(v, ",")
+		results, err := sweep.RunSweepers(regions, opts)
+
+		if opts.DryRun && results != nil {
+			if err := writeInventory(regions, results); err != nil {
+				log.Printf("[ERROR] Writing sweeper inventory: %s", err)
+				os.Exit(1)
+			}
+		}
+
+		if err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+func writeInventory(regions []string, results map[string][]sweep.SweeperResult) error {
+	if *flagSweepInventory == "" {
+		return sweep.WriteInventory(os.Stdout, regions, results)
+	}
+
+	f, err := os.Create(*flagSweepInventory)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return sweep.WriteInventory(f, regions, results)
+}
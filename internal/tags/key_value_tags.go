@@ -53,6 +53,11 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to a local tag policy document
+	//
+	// When set, the tag policy is read from this file instead of being retrieved from AWS.
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -78,6 +83,10 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagRules are the tag key capitalization and value rules defined in the
+	// effective tag policy
+	TagRules []TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
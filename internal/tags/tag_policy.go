@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule is the rule for a single tag key in an effective tag policy.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values. A value ending in "*" matches any
+	// value with that prefix. An empty slice allows any value.
+	Values []string
+
+	// EnforcedFor are the Terraform resource type names for which noncompliant
+	// tagging operations are prevented by the policy.
+	EnforcedFor []string
+}
+
+// TagPolicyViolation describes a tag which does not comply with a tag policy rule.
+type TagPolicyViolation struct {
+	Key    string
+	Detail string
+
+	// Enforced is true if the policy enforces the rule for the resource type.
+	Enforced bool
+}
+
+// String returns a human readable description of the violation.
+func (v TagPolicyViolation) String() string {
+	return fmt.Sprintf("%s: %s", v.Key, v.Detail)
+}
+
+// TagRuleViolations returns the tags which violate the key capitalization or
+// value rules of the effective tag policy, sorted by tag key.
+func (c *TagPolicyConfig) TagRuleViolations(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if c == nil || len(c.TagRules) == 0 {
+		return nil
+	}
+
+	var violations []TagPolicyViolation
+	for _, key := range tags.Keys() {
+		idx := slices.IndexFunc(c.TagRules, func(rule TagPolicyRule) bool {
+			return strings.EqualFold(rule.Key, key)
+		})
+		if idx == -1 {
+			continue
+		}
+		rule := c.TagRules[idx]
+		enforced := slices.Contains(rule.EnforcedFor, typeName)
+
+		if key != rule.Key {
+			violations = append(violations, TagPolicyViolation{
+				Key:      key,
+				Detail:   fmt.Sprintf("key must be capitalized as %q", rule.Key),
+				Enforced: enforced,
+			})
+		}
+
+		value := tags.KeyValue(key)
+		if value == nil || len(rule.Values) == 0 {
+			continue
+		}
+		if !slices.ContainsFunc(rule.Values, func(allowed string) bool {
+			if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+				return strings.HasPrefix(*value, prefix)
+			}
+			return *value == allowed
+		}) {
+			violations = append(violations, TagPolicyViolation{
+				Key:      key,
+				Detail:   fmt.Sprintf("value %q is not one of the allowed values %q", *value, rule.Values),
+				Enforced: enforced,
+			})
+		}
+	}
+
+	slices.SortStableFunc(violations, func(a, b TagPolicyViolation) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return violations
+}
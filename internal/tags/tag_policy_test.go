@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyConfigTagRuleViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &TagPolicyConfig{
+		Severity: "error",
+		TagRules: []TagPolicyRule{
+			{
+				Key:         "CostCenter",
+				Values:      []string{"100", "200"},
+				EnforcedFor: []string{"aws_instance"},
+			},
+			{
+				Key:    "Project",
+				Values: []string{"alpha-*"},
+			},
+			{
+				Key: "Owner",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *TagPolicyConfig
+		typeName string
+		tags     KeyValueTags
+		want     []TagPolicyViolation
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"costcenter": "300",
+			}),
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Project":    "alpha-1",
+				"Owner":      "team",
+				"Other":      "value",
+			}),
+		},
+		{
+			name:     "enforced value",
+			config:   config,
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+			}),
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Detail: `value "300" is not one of the allowed values ["100" "200"]`, Enforced: true},
+			},
+		},
+		{
+			name:     "unenforced value",
+			config:   config,
+			typeName: "aws_vpc",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300",
+				"Project":    "beta-1",
+			}),
+			want: []TagPolicyViolation{
+				{Key: "CostCenter", Detail: `value "300" is not one of the allowed values ["100" "200"]`},
+				{Key: "Project", Detail: `value "beta-1" is not one of the allowed values ["alpha-*"]`},
+			},
+		},
+		{
+			name:     "capitalization",
+			config:   config,
+			typeName: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"costcenter": "100",
+				"OWNER":      "team",
+			}),
+			want: []TagPolicyViolation{
+				{Key: "OWNER", Detail: `key must be capitalized as "Owner"`},
+				{Key: "costcenter", Detail: `key must be capitalized as "CostCenter"`, Enforced: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.TagRuleViolations(testCase.typeName, testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
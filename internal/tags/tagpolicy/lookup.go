@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"slices"
+	"sync"
+)
+
+var reverseLookup = sync.OnceValue(func() map[string][]string {
+	m := make(map[string][]string)
+	for tagrisType, tfTypes := range Lookup {
+		for _, tfType := range tfTypes {
+			m[tfType] = append(m[tfType], tagrisType)
+		}
+	}
+	for _, v := range m {
+		slices.Sort(v)
+	}
+	return m
+})
+
+// ResourceTypes returns the Tagris resource type names, in sorted order, which
+// correspond to the specified Terraform resource type.
+// The result is suitable for use as the Resource Groups Tagging API's
+// ResourceTypeFilters.
+func ResourceTypes(tfType string) []string {
+	return slices.Clone(reverseLookup()[tfType])
+}
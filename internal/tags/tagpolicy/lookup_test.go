@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		tfType string
+		want   []string
+	}{
+		{
+			name:   "single",
+			tfType: "aws_ecs_cluster",
+			want:   []string{"ecs:cluster"},
+		},
+		{
+			name:   "not found",
+			tfType: "aws_not_a_resource",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ResourceTypes(tc.tfType)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceTypes_Complete(t *testing.T) {
+	t.Parallel()
+
+	for tagrisType, tfTypes := range Lookup {
+		for _, tfType := range tfTypes {
+			if !slices.Contains(ResourceTypes(tfType), tagrisType) {
+				t.Errorf("ResourceTypes(%q) does not include %q", tfType, tagrisType)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type policyFileKey struct {
+	TagKey               json.RawMessage `json:"tag_key"`
+	ReportRequiredTagFor json.RawMessage `json:"report_required_tag_for"`
+}
+
+// ReadPolicyFile reads a tag policy document from the local filesystem and
+// returns the required tags per Terraform resource type and the tag key and
+// value rules it defines.
+//
+// The document may use either the tag policy syntax, including the "@@assign"
+// operator, or the effective tag policy syntax. No AWS API calls are made.
+func ReadPolicyFile(ctx context.Context, path string) (map[string]tftags.KeyValueTags, []tftags.TagPolicyRule, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading tag policy file (%s): %w", path, err)
+	}
+
+	reqTags, err := convertPolicyRequiredTags(ctx, string(b))
+	if err != nil {
+		return nil, nil, fmt.Errorf("tag policy file (%s): %w", path, err)
+	}
+
+	tagRules, err := convertTagRules(string(b))
+	if err != nil {
+		return nil, nil, fmt.Errorf("tag policy file (%s): %w", path, err)
+	}
+
+	return reqTags, tagRules, nil
+}
+
+// convertPolicyRequiredTags translates the "report_required_tag_for" elements
+// of a tag policy document into the equivalent ListRequiredTags API response
+// and then into a map of required tags per Terraform resource type
+func convertPolicyRequiredTags(ctx context.Context, content string) (map[string]tftags.KeyValueTags, error) {
+	var policy struct {
+		Tags map[string]policyFileKey `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	var reqTags []types.RequiredTag
+	for _, name := range slices.Sorted(maps.Keys(policy.Tags)) {
+		v := policy.Tags[name]
+
+		key := name
+		if err := unmarshalAssigned(v.TagKey, &key); err != nil {
+			return nil, fmt.Errorf("parsing tag policy (%s) tag_key: %w", name, err)
+		}
+
+		var resourceTypes []string
+		if err := unmarshalAssigned(v.ReportRequiredTagFor, &resourceTypes); err != nil {
+			return nil, fmt.Errorf("parsing tag policy (%s) report_required_tag_for: %w", name, err)
+		}
+
+		for _, resourceType := range expandResourceTypes(resourceTypes) {
+			reqTags = append(reqTags, types.RequiredTag{
+				ResourceType:     aws.String(resourceType),
+				ReportingTagKeys: []string{key},
+			})
+		}
+	}
+
+	return convert(ctx, reqTags), nil
+}
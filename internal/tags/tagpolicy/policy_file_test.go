@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestReadPolicyFile(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	path := filepath.Join(t.TempDir(), "tag-policy.json")
+	content := `{
+  "tags": {
+    "owner": {
+      "tag_key": {
+        "@@assign": "Owner"
+      },
+      "report_required_tag_for": {
+        "@@assign": [
+          "logs:log-group",
+          "acm:ALL_SUPPORTED"
+        ]
+      }
+    },
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "tag_value": {
+        "@@assign": [
+          "100"
+        ]
+      },
+      "report_required_tag_for": {
+        "@@assign": [
+          "logs:log-group"
+        ]
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reqTags, tagRules, err := ReadPolicyFile(ctx, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gotReqTags := make(map[string][]string)
+	for k, v := range reqTags {
+		keys := v.Keys()
+		slices.Sort(keys)
+		gotReqTags[k] = keys
+	}
+	wantReqTags := map[string][]string{
+		"aws_acm_certificate":      {"Owner"},
+		"aws_cloudwatch_log_group": {"CostCenter", "Owner"},
+	}
+	if diff := cmp.Diff(gotReqTags, wantReqTags); diff != "" {
+		t.Errorf("unexpected required tags diff (+wanted, -got): %s", diff)
+	}
+
+	wantTagRules := []tftags.TagPolicyRule{
+		{
+			Key:    "CostCenter",
+			Values: []string{"100"},
+		},
+		{
+			Key: "Owner",
+		},
+	}
+	if diff := cmp.Diff(tagRules, wantTagRules); diff != "" {
+		t.Errorf("unexpected tag rules diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestReadPolicyFile_notFound(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	_, _, err := ReadPolicyFile(ctx, filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// allSupportedResourceTypes is the resource type suffix which applies
+	// a tag policy rule to all supported resource types of a service
+	allSupportedResourceTypes = "ALL_SUPPORTED"
+
+	// assignOperator is the tag policy inheritance operator which sets a value
+	assignOperator = "@@assign"
+)
+
+// GetTagRules returns the tag key and value rules from the effective tag policy
+// of the calling account.
+//
+// If no tag policy applies to the account, an empty result is returned.
+func GetTagRules(ctx context.Context, awsConfig aws.Config) ([]tftags.TagPolicyRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: types.EffectivePolicyTypeTagPolicy,
+	})
+
+	if errs.IsA[*types.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return convertTagRules(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
+type effectiveTagPolicy struct {
+	Tags map[string]effectiveTagPolicyKey `json:"tags"`
+}
+
+type effectiveTagPolicyKey struct {
+	TagKey      json.RawMessage `json:"tag_key"`
+	TagValue    json.RawMessage `json:"tag_value"`
+	EnforcedFor json.RawMessage `json:"enforced_for"`
+}
+
+// convertTagRules translates effective tag policy content into tag rules,
+// mapping enforced resource types to Terraform resource types
+func convertTagRules(content string) ([]tftags.TagPolicyRule, error) {
+	var policy effectiveTagPolicy
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("parsing effective tag policy: %w", err)
+	}
+
+	var rules []tftags.TagPolicyRule
+	for _, name := range slices.Sorted(maps.Keys(policy.Tags)) {
+		v := policy.Tags[name]
+		rule := tftags.TagPolicyRule{
+			Key: name,
+		}
+
+		if err := unmarshalAssigned(v.TagKey, &rule.Key); err != nil {
+			return nil, fmt.Errorf("parsing effective tag policy (%s) tag_key: %w", name, err)
+		}
+
+		if err := unmarshalAssigned(v.TagValue, &rule.Values); err != nil {
+			return nil, fmt.Errorf("parsing effective tag policy (%s) tag_value: %w", name, err)
+		}
+
+		var enforcedFor []string
+		if err := unmarshalAssigned(v.EnforcedFor, &enforcedFor); err != nil {
+			return nil, fmt.Errorf("parsing effective tag policy (%s) enforced_for: %w", name, err)
+		}
+		rule.EnforcedFor = terraformResourceTypes(enforcedFor)
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// unmarshalAssigned unmarshals a tag policy value, which may be either a
+// literal value or an object containing the "@@assign" operator
+func unmarshalAssigned(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &operators); err == nil {
+		raw = operators[assignOperator]
+		if len(raw) == 0 {
+			return nil
+		}
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// terraformResourceTypes translates Tagris resource type names, including
+// "<service>:ALL_SUPPORTED" wildcards, into sorted Terraform resource type names
+func terraformResourceTypes(resourceTypes []string) []string {
+	var tfTypes []string
+	for _, resourceType := range expandResourceTypes(resourceTypes) {
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+
+	slices.Sort(tfTypes)
+	return slices.Compact(tfTypes)
+}
+
+// expandResourceTypes replaces any "<service>:ALL_SUPPORTED" wildcards with
+// the matching Tagris resource type names
+func expandResourceTypes(resourceTypes []string) []string {
+	var expanded []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupportedResourceTypes); ok {
+			for k := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					expanded = append(expanded, k)
+				}
+			}
+			continue
+		}
+
+		expanded = append(expanded, resourceType)
+	}
+
+	slices.Sort(expanded)
+	return slices.Compact(expanded)
+}
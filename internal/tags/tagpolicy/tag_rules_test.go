@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestConvertTagRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		want    []tftags.TagPolicyRule
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			content: `{}`,
+		},
+		{
+			name: "effective policy",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200"],
+      "enforced_for": ["cloudtrail:trail", "acm:ALL_SUPPORTED"]
+    },
+    "owner": {
+      "tag_key": "Owner"
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100", "200"},
+					EnforcedFor: []string{"aws_acm_certificate", "aws_cloudtrail"},
+				},
+				{
+					Key: "Owner",
+				},
+			},
+		},
+		{
+			name: "assign operators",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100"]},
+      "enforced_for": {"@@assign": ["cloudtrail:trail"]}
+    }
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100"},
+					EnforcedFor: []string{"aws_cloudtrail"},
+				},
+			},
+		},
+		{
+			name:    "invalid",
+			content: `{"tags": {"costcenter": {"tag_value": 1}}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := convertTagRules(testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error = %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRAccountID          = "VCR_ACCOUNT_ID"
+	envVarVCRMode               = "VCR_MODE"
+	envVarVCRPath               = "VCR_PATH"
+	envVarVCRSensitiveJSONPaths = "VCR_SENSITIVE_JSON_PATHS"
 
 	vcrModeRecordOnly = "RECORD_ONLY"
 	vcrModeReplayOnly = "REPLAY_ONLY"
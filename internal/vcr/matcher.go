@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	ignoredFields = map[string][]string{
+		// Idempotency tokens are generated afresh for each request.
+		"": {"ClientRequestToken", "ClientToken", "IdempotencyToken"},
+	}
+	ignoredFieldsLock sync.RWMutex
+
+	unorderedFields = map[string][]string{
+		// Filters and tags are sets, but are serialized in map iteration order.
+		"": {"Filter", "Filter.Value", "Tag", "TagSpecification", "TagSpecification.Tag", "Tags.member"},
+	}
+	unorderedFieldsLock sync.RWMutex
+)
+
+// IgnoreFields registers request body fields that are ignored when matching requests for the specified service to recorded interactions.
+// The service is the signing name of the service, e.g. "ec2". An empty service applies to all services.
+// For form-encoded (AWS Query and EC2 Query protocol) bodies, a field matches a parameter with the same name and all of its members,
+// e.g. "TagSpecification" matches "TagSpecification.1.Tag.1.Key". For JSON and CBOR bodies, a field matches an object key at any depth.
+func IgnoreFields(service string, fields ...string) {
+	ignoredFieldsLock.Lock()
+	defer ignoredFieldsLock.Unlock()
+
+	ignoredFields[service] = append(ignoredFields[service], fields...)
+}
+
+func ignoredFieldsForService(service string) []string {
+	ignoredFieldsLock.RLock()
+	defer ignoredFieldsLock.RUnlock()
+
+	return slices.Concat(ignoredFields[""], ignoredFields[service])
+}
+
+// UnorderedFields registers form-encoded list parameters whose member order is ignored when matching requests for the specified service to recorded interactions.
+// The service is the signing name of the service, e.g. "ec2". An empty service applies to all services.
+// A field is the parameter's name without list indexes, e.g. "Filter.Value" for "Filter.N.Value.N", and also matches a nested parameter with the same name.
+// The order of all other list members is significant.
+func UnorderedFields(service string, fields ...string) {
+	unorderedFieldsLock.Lock()
+	defer unorderedFieldsLock.Unlock()
+
+	unorderedFields[service] = append(unorderedFields[service], fields...)
+}
+
+func unorderedFieldsForService(service string) []string {
+	unorderedFieldsLock.RLock()
+	defer unorderedFieldsLock.RUnlock()
+
+	return slices.Concat(unorderedFields[""], unorderedFields[service])
+}
+
+// RequestBodiesMatch returns whether a request body matches the body of a recorded request.
+// Bodies are compared in a canonical form based on the request's protocol so that, for example,
+// reordered JSON object keys or Query list members still match.
+func RequestBodiesMatch(ctx context.Context, r *http.Request, body, recordedBody string) bool {
+	if body == recordedBody {
+		return true
+	}
+
+	service := serviceFromRequest(r)
+	ignored := ignoredFieldsForService(service)
+
+	// https://smithy.io/2.0/aws/protocols/index.html.
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return bodiesMatch(ctx, body, recordedBody, func(s string) (any, error) {
+			return decodeJSON(s, ignored)
+		})
+
+	case "application/xml":
+		return bodiesMatch(ctx, body, recordedBody, func(s string) (any, error) {
+			var v any
+			err := xml.Unmarshal([]byte(s), &v)
+			return v, err
+		})
+
+	case "application/x-www-form-urlencoded":
+		return bodiesMatch(ctx, body, recordedBody, func(s string) (any, error) {
+			return canonicalForm(s, ignored, unorderedFieldsForService(service))
+		})
+
+	case "application/cbor":
+		return bodiesMatch(ctx, body, recordedBody, func(s string) (any, error) {
+			return decodeCBOR(s, ignored)
+		})
+	}
+
+	return false
+}
+
+func bodiesMatch(ctx context.Context, body, recordedBody string, decode func(string) (any, error)) bool {
+	v1, err := decode(body)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode request body", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	v2, err := decode(recordedBody)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode cassette request body", map[string]any{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+// serviceFromRequest returns the signing name of the service a request is sent to.
+func serviceFromRequest(r *http.Request) string {
+	// Authorization: AWS4-HMAC-SHA256 Credential=AKID/20260102/us-west-2/ec2/aws4_request, ...
+	if _, credential, ok := strings.Cut(r.Header.Get("Authorization"), "Credential="); ok {
+		credential, _, _ = strings.Cut(credential, ",")
+		if parts := strings.Split(credential, "/"); len(parts) == 5 {
+			return parts[3]
+		}
+	}
+
+	// Fall back to the first label of the endpoint's host name, e.g. "ec2.us-west-2.amazonaws.com".
+	service, _, _ := strings.Cut(r.URL.Hostname(), ".")
+	return service
+}
+
+func decodeJSON(s string, ignored []string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return removeKeys(v, ignored), nil
+}
+
+func decodeCBOR(s string, ignored []string) (any, error) {
+	v, err := cbor.Decode([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return removeCBORKeys(v, ignored), nil
+}
+
+// removeKeys removes the ignored keys from all objects in a decoded JSON value.
+func removeKeys(v any, ignored []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if slices.Contains(ignored, k) {
+				delete(v, k)
+			} else {
+				v[k] = removeKeys(e, ignored)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = removeKeys(e, ignored)
+		}
+	}
+
+	return v
+}
+
+// removeCBORKeys removes the ignored keys from all maps in a decoded CBOR value.
+func removeCBORKeys(v cbor.Value, ignored []string) cbor.Value {
+	switch v := v.(type) {
+	case cbor.Map:
+		for k, e := range v {
+			if slices.Contains(ignored, k) {
+				delete(v, k)
+			} else {
+				v[k] = removeCBORKeys(e, ignored)
+			}
+		}
+	case cbor.List:
+		for i, e := range v {
+			v[i] = removeCBORKeys(e, ignored)
+		}
+	case *cbor.Tag:
+		v.Value = removeCBORKeys(v.Value, ignored)
+	}
+
+	return v
+}
+
+// canonicalForm returns a canonical representation of a form-encoded AWS Query or EC2 Query protocol body.
+// Members of lists and maps, e.g. "Filter.N", "Tag.N" or "Tags.member.N", are serialized with 1-based indexes.
+// The canonical form ignores the order of the members of unordered lists.
+func canonicalForm(s string, ignored, unordered []string) (string, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return "", err
+	}
+
+	root := new(formNode)
+	for k, v := range values {
+		if slices.ContainsFunc(ignored, func(field string) bool {
+			return k == field || strings.HasPrefix(k, field+".")
+		}) {
+			continue
+		}
+
+		node := root
+		for part := range strings.SplitSeq(k, ".") {
+			node = node.child(part)
+		}
+		node.value = aws.String(strings.Join(v, ","))
+	}
+
+	var buf bytes.Buffer
+	root.write(&buf, nil, unordered)
+
+	return buf.String(), nil
+}
+
+// formNode is a node in the tree of form parameters.
+// A parameter can have both a value and nested parameters, e.g. "Foo" and "Foo.1".
+type formNode struct {
+	value    *string
+	children map[string]*formNode
+}
+
+func (n *formNode) child(name string) *formNode {
+	if n.children == nil {
+		n.children = make(map[string]*formNode)
+	}
+
+	child, ok := n.children[name]
+	if !ok {
+		child = new(formNode)
+		n.children[name] = child
+	}
+
+	return child
+}
+
+// write writes the node's canonical representation. path is the names of the node and its ancestors, excluding list indexes.
+func (n *formNode) write(buf *bytes.Buffer, path []string, unordered []string) {
+	if n.value != nil {
+		fmt.Fprintf(buf, "%q", *n.value)
+	}
+	if len(n.children) == 0 {
+		return
+	}
+
+	keys := slices.Sorted(maps.Keys(n.children))
+
+	// A node whose keys are all list indexes is a list.
+	isList := !slices.ContainsFunc(keys, func(k string) bool {
+		_, err := strconv.Atoi(k)
+		return err != nil
+	})
+
+	if isList && isUnorderedList(path, unordered) {
+		// Members are sorted by their canonical representation.
+		members := make([]string, 0, len(keys))
+		for _, k := range keys {
+			var member bytes.Buffer
+			n.children[k].write(&member, path, unordered)
+			members = append(members, member.String())
+		}
+		slices.Sort(members)
+
+		buf.WriteString("[")
+		buf.WriteString(strings.Join(members, ","))
+		buf.WriteString("]")
+		return
+	}
+
+	if isList {
+		// Members are ordered by index, not lexically.
+		slices.SortFunc(keys, func(a, b string) int {
+			i, _ := strconv.Atoi(a)
+			j, _ := strconv.Atoi(b)
+			return i - j
+		})
+	}
+
+	buf.WriteString("{")
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(buf, "%q:", k)
+
+		childPath := path
+		if !isList {
+			childPath = append(slices.Clip(path), k)
+		}
+		n.children[k].write(buf, childPath, unordered)
+	}
+	buf.WriteString("}")
+}
+
+// isUnorderedList returns whether the list at the specified path is one of the unordered fields.
+func isUnorderedList(path []string, unordered []string) bool {
+	name := strings.Join(path, ".")
+
+	return slices.ContainsFunc(unordered, func(field string) bool {
+		return name == field || strings.HasSuffix(name, "."+field)
+	})
+}
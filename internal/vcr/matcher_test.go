@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func TestRequestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	vcr.IgnoreFields("testservice", "Nonce")
+	vcr.UnorderedFields("testservice", "Items.member")
+
+	testCases := map[string]struct {
+		url          string
+		contentType  string
+		body         string
+		recordedBody string
+		expected     bool
+	}{
+		"identical": {
+			contentType:  "text/plain",
+			body:         "abc",
+			recordedBody: "abc",
+			expected:     true,
+		},
+		"unknown content type": {
+			contentType:  "text/plain",
+			body:         "abc",
+			recordedBody: "abd",
+		},
+		"JSON reordered": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"a":1,"b":[1,2]}`,
+			recordedBody: `{"b":[1,2],"a":1}`,
+			expected:     true,
+		},
+		"JSON different": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"a":1,"b":[1,2]}`,
+			recordedBody: `{"a":1,"b":[2,1]}`,
+		},
+		"JSON idempotency token": {
+			contentType:  "application/x-amz-json-1.0",
+			body:         `{"Name":"test","ClientToken":"e5a1c2b4"}`,
+			recordedBody: `{"ClientToken":"0f9d8e7c","Name":"test"}`,
+			expected:     true,
+		},
+		"form reordered": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=DescribeVpcs&Version=2016-11-15&Filter.1.Name=tag%3AName&Filter.1.Value.1=test&Filter.2.Name=cidr&Filter.2.Value.1=10.0.0.0%2F16",
+			recordedBody: "Version=2016-11-15&Action=DescribeVpcs&Filter.1.Name=cidr&Filter.1.Value.1=10.0.0.0%2F16&Filter.2.Name=tag%3AName&Filter.2.Value.1=test",
+			expected:     true,
+		},
+		"form member list reordered": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=TagRole&RoleName=test&Tags.member.1.Key=a&Tags.member.1.Value=1&Tags.member.2.Key=b&Tags.member.2.Value=2",
+			recordedBody: "Action=TagRole&RoleName=test&Tags.member.1.Key=b&Tags.member.1.Value=2&Tags.member.2.Key=a&Tags.member.2.Value=1",
+			expected:     true,
+		},
+		"form nested list reordered": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=RunInstances&TagSpecification.1.ResourceType=instance&TagSpecification.1.Tag.1.Key=a&TagSpecification.1.Tag.1.Value=1&TagSpecification.1.Tag.2.Key=b&TagSpecification.1.Tag.2.Value=2",
+			recordedBody: "Action=RunInstances&TagSpecification.1.ResourceType=instance&TagSpecification.1.Tag.1.Key=b&TagSpecification.1.Tag.1.Value=2&TagSpecification.1.Tag.2.Key=a&TagSpecification.1.Tag.2.Value=1",
+			expected:     true,
+		},
+		"form ordered list reordered": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=CreateListener&DefaultActions.member.1.Type=authenticate-oidc&DefaultActions.member.1.Order=1&DefaultActions.member.2.Type=forward&DefaultActions.member.2.Order=2",
+			recordedBody: "Action=CreateListener&DefaultActions.member.1.Type=forward&DefaultActions.member.1.Order=2&DefaultActions.member.2.Type=authenticate-oidc&DefaultActions.member.2.Order=1",
+		},
+		"form ordered list more than 9 members": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Items.1=a&Items.2=b&Items.3=c&Items.4=d&Items.5=e&Items.6=f&Items.7=g&Items.8=h&Items.9=i&Items.10=j",
+			recordedBody: "Items.10=j&Items.9=i&Items.8=h&Items.7=g&Items.6=f&Items.5=e&Items.4=d&Items.3=c&Items.2=b&Items.1=a&Action=Test",
+			expected:     true,
+		},
+		"form scalar and nested parameter": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Foo=a&Foo.1=b",
+			recordedBody: "Action=Test&Foo.1=b",
+		},
+		"form service unordered field": {
+			url:          "https://testservice.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Items.member.1=a&Items.member.2=b",
+			recordedBody: "Action=Test&Items.member.1=b&Items.member.2=a",
+			expected:     true,
+		},
+		"form other service unordered field": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Items.member.1=a&Items.member.2=b",
+			recordedBody: "Action=Test&Items.member.1=b&Items.member.2=a",
+		},
+		"form different": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=TagRole&RoleName=test&Tags.member.1.Key=a&Tags.member.1.Value=1",
+			recordedBody: "Action=TagRole&RoleName=test&Tags.member.1.Key=a&Tags.member.1.Value=2",
+		},
+		"form idempotency token": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=RunInstances&ClientToken=e5a1c2b4&MaxCount=1",
+			recordedBody: "Action=RunInstances&ClientToken=0f9d8e7c&MaxCount=1",
+			expected:     true,
+		},
+		"form service ignored field": {
+			url:          "https://testservice.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Nonce.1=a&Nonce.2=b",
+			recordedBody: "Action=Test&Nonce.1=c",
+			expected:     true,
+		},
+		"form other service ignored field": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=Test&Nonce.1=a&Nonce.2=b",
+			recordedBody: "Action=Test&Nonce.1=c",
+		},
+		"CBOR reordered": {
+			contentType:  "application/cbor",
+			body:         string(cbor.Encode(cbor.Map{"a": cbor.Uint(1), "b": cbor.String("x"), "ClientToken": cbor.String("e5a1c2b4")})),
+			recordedBody: string(cbor.Encode(cbor.Map{"b": cbor.String("x"), "a": cbor.Uint(1), "ClientToken": cbor.String("0f9d8e7c")})),
+			expected:     true,
+		},
+		"CBOR different": {
+			contentType:  "application/cbor",
+			body:         string(cbor.Encode(cbor.Map{"a": cbor.Uint(1)})),
+			recordedBody: string(cbor.Encode(cbor.Map{"a": cbor.Uint(2)})),
+		},
+		"CBOR invalid": {
+			contentType:  "application/cbor",
+			body:         string(cbor.Encode(cbor.Map{"a": cbor.Uint(1)})),
+			recordedBody: "\xff",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			url := testCase.url
+			if url == "" {
+				url = "https://ec2.us-west-2.amazonaws.com/" //lintignore:AWSAT003
+			}
+			r := httptest.NewRequest(http.MethodPost, url, strings.NewReader(testCase.body))
+			r.Header.Set("Content-Type", testCase.contentType)
+
+			if got, want := vcr.RequestBodiesMatch(t.Context(), r, testCase.body, testCase.recordedBody), testCase.expected; got != want {
+				t.Errorf("RequestBodiesMatch: got %t, want %t", got, want)
+			}
+		})
+	}
+}
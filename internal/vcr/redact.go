@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// Placeholders written to cassettes in place of account- and Region-specific values.
+const (
+	PlaceholderAccountID       = "{{AccountID}}"
+	PlaceholderAlternateRegion = "{{AlternateRegion}}"
+	PlaceholderPartition       = "{{Partition}}"
+	PlaceholderRegion          = "{{Region}}"
+	PlaceholderThirdRegion     = "{{ThirdRegion}}"
+
+	redacted = "REDACTED"
+
+	// defaultReplayAccountID is substituted for PlaceholderAccountID on replay unless VCR_ACCOUNT_ID is set.
+	defaultReplayAccountID = "123456789012"
+)
+
+// defaultSensitiveJSONPaths are the JSON paths whose values are always redacted.
+// A path is a dot-separated list of object keys. "*" matches any key or array element.
+var defaultSensitiveJSONPaths = []string{
+	"Credentials.SecretAccessKey",
+	"Credentials.SessionToken",
+	"Password",
+	"SecretBinary",
+	"SecretString",
+}
+
+var (
+	presignedURLParameterRegexp = regexp.MustCompile(`(X-Amz-(?:Credential|Security-Token|Signature)=)[^&"'\s<]+`)
+	ipv4AddressRegexp           = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+
+	// redactedIPAddressRange is the documentation address range 198.51.100.0/24 (RFC 5737).
+	redactedIPAddressRange = &net.IPNet{IP: net.IPv4(198, 51, 100, 0), Mask: net.CIDRMask(24, 32)}
+)
+
+// Redactor rewrites recorded interactions so that cassettes can be committed and replayed in any account or Region.
+// On record, account IDs, partitions and Regions are replaced by placeholders, and sensitive values are redacted.
+// On replay, placeholders in responses are replaced by the values for the replaying account and Region.
+type Redactor struct {
+	// replacements maps values to placeholders, longest value first.
+	replacements   []replacement
+	sensitivePaths [][]string
+	ipAddresses    map[string]string
+	lock           sync.Mutex
+}
+
+type replacement struct {
+	value, placeholder string
+}
+
+// NewRedactor returns a new Redactor.
+// Sensitive JSON paths are the defaults plus any listed, comma-separated, in the VCR_SENSITIVE_JSON_PATHS environment variable.
+func NewRedactor() *Redactor {
+	r := &Redactor{
+		ipAddresses: make(map[string]string),
+	}
+
+	r.AddSensitiveJSONPaths(defaultSensitiveJSONPaths...)
+	if v := os.Getenv(envVarVCRSensitiveJSONPaths); v != "" {
+		r.AddSensitiveJSONPaths(strings.Split(v, ",")...)
+	}
+
+	return r
+}
+
+// AddSensitiveJSONPaths adds JSON paths whose values are redacted in request and response bodies.
+func (r *Redactor) AddSensitiveJSONPaths(paths ...string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, path := range paths {
+		if path = strings.TrimSpace(path); path != "" {
+			r.sensitivePaths = append(r.sensitivePaths, strings.Split(path, "."))
+		}
+	}
+}
+
+// AddReplacement replaces value by placeholder in recorded interactions, and placeholder by value in replayed responses.
+func (r *Redactor) AddReplacement(value, placeholder string) {
+	if value == "" {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.replacements = append(r.replacements, replacement{value: value, placeholder: placeholder})
+	slices.SortStableFunc(r.replacements, func(a, b replacement) int {
+		return len(b.value) - len(a.value)
+	})
+}
+
+// AddAccountAndRegions adds the replacements for an account, partition and Regions.
+func (r *Redactor) AddAccountAndRegions(accountID, partition, region, alternateRegion, thirdRegion string) {
+	r.AddReplacement(accountID, PlaceholderAccountID)
+	if partition != "" {
+		r.AddReplacement("arn:"+partition+":", "arn:"+PlaceholderPartition+":")
+	}
+	r.AddReplacement(region, PlaceholderRegion)
+	if alternateRegion != region {
+		r.AddReplacement(alternateRegion, PlaceholderAlternateRegion)
+	}
+	if thirdRegion != region && thirdRegion != alternateRegion {
+		r.AddReplacement(thirdRegion, PlaceholderThirdRegion)
+	}
+}
+
+// ReplayAccountID returns the account ID substituted for PlaceholderAccountID on replay.
+func ReplayAccountID() string {
+	if v := os.Getenv(envVarVCRAccountID); v != "" {
+		return v
+	}
+	return defaultReplayAccountID
+}
+
+// BeforeSaveHook is a recorder.BeforeSaveHook that redacts and normalizes an interaction.
+func (r *Redactor) BeforeSaveHook(i *cassette.Interaction) error {
+	i.Request.URL = r.NormalizeURL(i.Request.URL)
+	i.Request.Body = r.NormalizeBody(i.Request.Headers.Get("Content-Type"), i.Request.Body)
+	i.Request.ContentLength = int64(len(i.Request.Body))
+	for k, v := range i.Request.Form {
+		for j := range v {
+			i.Request.Form[k][j] = r.normalize(v[j])
+		}
+	}
+
+	i.Response.Body = r.NormalizeBody(i.Response.Headers.Get("Content-Type"), i.Response.Body)
+	setContentLength(&i.Response, len(i.Response.Body))
+	for k, v := range i.Response.Headers {
+		for j := range v {
+			i.Response.Headers[k][j] = r.normalize(v[j])
+		}
+	}
+
+	return nil
+}
+
+// BeforeResponseReplayHook is a recorder.BeforeResponseReplayHook that replaces placeholders in a replayed response.
+func (r *Redactor) BeforeResponseReplayHook(i *cassette.Interaction) error {
+	i.Response.Body = r.denormalize(i.Response.Body)
+	setContentLength(&i.Response, len(i.Response.Body))
+	for k, v := range i.Response.Headers {
+		for j := range v {
+			i.Response.Headers[k][j] = r.denormalize(v[j])
+		}
+	}
+
+	return nil
+}
+
+// NormalizeURL returns a request URL as it is written to a cassette.
+func (r *Redactor) NormalizeURL(url string) string {
+	return r.normalize(url)
+}
+
+// NormalizeBody returns a request or response body as it is written to a cassette.
+func (r *Redactor) NormalizeBody(contentType, body string) string {
+	if body == "" {
+		return body
+	}
+
+	switch mediaType, _, _ := mime.ParseMediaType(contentType); mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		body = r.redactJSON(body)
+	case "application/xml", "text/xml":
+		body = r.redactXML(body)
+	}
+
+	return r.normalize(body)
+}
+
+// normalize replaces account- and Region-specific values by placeholders and redacts presigned URLs and public IP addresses.
+func (r *Redactor) normalize(s string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, v := range r.replacements {
+		s = strings.ReplaceAll(s, v.value, v.placeholder)
+	}
+
+	s = presignedURLParameterRegexp.ReplaceAllString(s, "${1}"+redacted)
+
+	s = ipv4AddressRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		ip := net.ParseIP(v)
+		if ip == nil || ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || redactedIPAddressRange.Contains(ip) {
+			return v
+		}
+
+		// Public IP addresses are consistently replaced by addresses from the documentation range.
+		if _, ok := r.ipAddresses[v]; !ok {
+			r.ipAddresses[v] = "198.51.100." + strconv.Itoa(len(r.ipAddresses)%254+1)
+		}
+		return r.ipAddresses[v]
+	})
+
+	return s
+}
+
+func (r *Redactor) denormalize(s string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, v := range r.replacements {
+		s = strings.ReplaceAll(s, v.placeholder, v.value)
+	}
+
+	return s
+}
+
+func (r *Redactor) redactJSON(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+
+	r.lock.Lock()
+	var changed bool
+	for _, path := range r.sensitivePaths {
+		changed = redactJSONPath(v, path) || changed
+	}
+	r.lock.Unlock()
+
+	if !changed {
+		return body
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return body
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// redactJSONPath redacts the value at path in v, returning whether anything was redacted.
+func redactJSONPath(v any, path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	var changed bool
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if path[0] != "*" && path[0] != k {
+				continue
+			}
+			if len(path) == 1 {
+				if e != nil {
+					v[k] = redacted
+					changed = true
+				}
+				continue
+			}
+			changed = redactJSONPath(e, path[1:]) || changed
+		}
+	case []any:
+		for i, e := range v {
+			if path[0] == "*" {
+				if len(path) == 1 {
+					v[i] = redacted
+					changed = true
+					continue
+				}
+				changed = redactJSONPath(e, path[1:]) || changed
+			} else {
+				// Arrays are transparent to paths without a wildcard.
+				changed = redactJSONPath(e, path) || changed
+			}
+		}
+	}
+
+	return changed
+}
+
+// redactXML redacts the content of elements named by the last key of each sensitive path.
+func (r *Redactor) redactXML(body string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, path := range r.sensitivePaths {
+		name := path[len(path)-1]
+		if name == "*" {
+			continue
+		}
+		re := regexp.MustCompile(fmt.Sprintf(`(<%[1]s>)[^<]*(</%[1]s>)`, regexp.QuoteMeta(name)))
+		body = re.ReplaceAllString(body, "${1}"+redacted+"${2}")
+	}
+
+	return body
+}
+
+func setContentLength(response *cassette.Response, n int) {
+	if response.ContentLength > 0 || response.Headers.Get("Content-Length") != "" {
+		response.ContentLength = int64(n)
+		if response.Headers != nil && response.Headers.Get("Content-Length") != "" {
+			response.Headers.Set("Content-Length", strconv.Itoa(n))
+		}
+	}
+}
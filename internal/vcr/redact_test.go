@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestRedactor(t *testing.T) { //nolint:tparallel // Uses t.Setenv.
+	t.Setenv("VCR_SENSITIVE_JSON_PATHS", "Parameter.Value")
+
+	const (
+		accountID = "111122223333"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	// Record.
+	recorder := vcr.NewRedactor()
+	recorder.AddAccountAndRegions(accountID, "aws", region, "us-east-1", "us-east-2") //lintignore:AWSAT003
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			URL:     "https://ssm.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			Body:    `{"Name":"test","Value":"s3cr3t"}`,
+			Headers: http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		},
+		Response: cassette.Response{
+			Body:    `{"Parameter":{"ARN":"arn:aws:ssm:us-west-2:111122223333:parameter/test","Value":"s3cr3t"},"Url":"https://example.s3.amazonaws.com/key?X-Amz-Signature=abcdef&X-Amz-Credential=AKID","PublicIp":"54.1.2.3","PrivateIp":"10.0.0.1"}`, //lintignore:AWSAT003,AWSAT005
+			Headers: http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		},
+	}
+
+	if err := recorder.BeforeSaveHook(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := i.Request.URL, "https://ssm.{{Region}}.amazonaws.com/"; got != want {
+		t.Errorf("request URL: got %q, want %q", got, want)
+	}
+	if got, want := i.Request.Body, `{"Name":"test","Value":"s3cr3t"}`; got != want {
+		t.Errorf("request body: got %q, want %q", got, want)
+	}
+	if got, want := i.Response.Body, `{"Parameter":{"ARN":"arn:{{Partition}}:ssm:{{Region}}:{{AccountID}}:parameter/test","Value":"REDACTED"},"PrivateIp":"10.0.0.1","PublicIp":"198.51.100.1","Url":"https://example.s3.amazonaws.com/key?X-Amz-Signature=REDACTED&X-Amz-Credential=REDACTED"}`; got != want {
+		t.Errorf("response body:\n got %s\nwant %s", got, want)
+	}
+
+	// Replay in another account and Region.
+	t.Setenv("VCR_ACCOUNT_ID", "444455556666")
+	replayer := vcr.NewRedactor()
+	replayer.AddAccountAndRegions(vcr.ReplayAccountID(), "aws", "eu-west-1", "eu-central-1", "eu-north-1") //lintignore:AWSAT003
+
+	if got, want := replayer.NormalizeURL("https://ssm.eu-west-1.amazonaws.com/"), i.Request.URL; got != want { //lintignore:AWSAT003
+		t.Errorf("replayed request URL: got %q, want %q", got, want)
+	}
+
+	if err := replayer.BeforeResponseReplayHook(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := i.Response.Body, `{"Parameter":{"ARN":"arn:aws:ssm:eu-west-1:444455556666:parameter/test","Value":"REDACTED"},"PrivateIp":"10.0.0.1","PublicIp":"198.51.100.1","Url":"https://example.s3.amazonaws.com/key?X-Amz-Signature=REDACTED&X-Amz-Credential=REDACTED"}`; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("replayed response body:\n got %s\nwant %s", got, want)
+	}
+}
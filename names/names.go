@@ -301,5 +301,8 @@ const (
 	ListResourceTopLevelRegionAttributeDescription = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
 	ActionTopLevelRegionAttributeDescription       = `Region where this action will be [executed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
 
+	ListResourceTagFilterBlockDescription        = `Only list resources with tags matching this filter, as resolved by the [Resource Groups Tagging API](https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html). Multiple blocks must all match. Cannot be combined with other arguments except region.`
+	ListResourceResourceExplorerBlockDescription = `Only list resources matching a [Resource Explorer](https://docs.aws.amazon.com/resource-explorer/latest/userguide/welcome.html) search. Cannot be combined with other arguments except region.`
+
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type FindingKind string
+
+const (
+	findingKindResourceRemoved         FindingKind = "resource_removed"
+	findingKindAttributeRemoved        FindingKind = "attribute_removed"
+	findingKindRequiredAttributeAdded  FindingKind = "required_attribute_added"
+	findingKindOptionalToRequired      FindingKind = "optional_to_required"
+	findingKindForceNewAdded           FindingKind = "force_new_added"
+	findingKindTypeChanged             FindingKind = "type_changed"
+	findingKindIdentityRemoved         FindingKind = "identity_removed"
+	findingKindIdentityAttributeChange FindingKind = "identity_attribute_changed"
+	findingKindMissingStateUpgrader    FindingKind = "missing_state_upgrader"
+)
+
+// Finding is a breaking change to, or missing state upgrader for, a resource.
+type Finding struct {
+	Resource  string      `json:"resource"`
+	Attribute string      `json:"attribute,omitempty"`
+	Kind      FindingKind `json:"kind"`
+	Detail    string      `json:"detail"`
+}
+
+// compare returns the breaking changes between the base and head provider schemas.
+func compare(base, head *ProviderSchema) []Finding {
+	var findings []Finding
+
+	for typeName, baseResource := range base.Resources {
+		headResource, ok := head.Resources[typeName]
+
+		if !ok {
+			findings = append(findings, Finding{
+				Resource: typeName,
+				Kind:     findingKindResourceRemoved,
+				Detail:   "resource removed",
+			})
+			continue
+		}
+
+		findings = append(findings, compareResource(typeName, baseResource, headResource)...)
+	}
+
+	slices.SortFunc(findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.Resource, b.Resource),
+			cmp.Compare(a.Attribute, b.Attribute),
+			cmp.Compare(a.Kind, b.Kind),
+		)
+	})
+
+	return findings
+}
+
+func compareResource(typeName string, base, head *ResourceSchema) []Finding {
+	var findings []Finding
+	var typeChanged bool
+
+	for path, baseAttr := range base.Attributes {
+		headAttr, ok := head.Attributes[path]
+
+		if !ok {
+			// Only report the removal of the outermost removed attribute or block.
+			if parent, ok := parentPath(path); !ok || head.Attributes[parent] != nil {
+				findings = append(findings, Finding{
+					Resource:  typeName,
+					Attribute: path,
+					Kind:      findingKindAttributeRemoved,
+					Detail:    "attribute removed",
+				})
+			}
+			continue
+		}
+
+		if baseAttr.Type != headAttr.Type {
+			typeChanged = true
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: path,
+				Kind:      findingKindTypeChanged,
+				Detail:    fmt.Sprintf("type changed from %s to %s", baseAttr.Type, headAttr.Type),
+			})
+		}
+
+		if !baseAttr.Required && headAttr.Required {
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: path,
+				Kind:      findingKindOptionalToRequired,
+				Detail:    "attribute changed to Required",
+			})
+		}
+
+		if !baseAttr.ForceNew && headAttr.ForceNew {
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: path,
+				Kind:      findingKindForceNewAdded,
+				Detail:    "ForceNew added",
+			})
+		}
+	}
+
+	for path, headAttr := range head.Attributes {
+		if _, ok := base.Attributes[path]; ok || !headAttr.Required {
+			continue
+		}
+
+		// A required attribute in a new optional block is not a breaking change.
+		if parent, ok := parentPath(path); ok && base.Attributes[parent] == nil {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Resource:  typeName,
+			Attribute: path,
+			Kind:      findingKindRequiredAttributeAdded,
+			Detail:    "required attribute added",
+		})
+	}
+
+	findings = append(findings, compareIdentity(typeName, base.Identity, head.Identity)...)
+
+	switch {
+	case head.Version > base.Version:
+		// Plugin SDK v2 state upgraders are chained, so one must exist for the base schema version.
+		if head.StateUpgraderVersions != nil && !slices.Contains(head.StateUpgraderVersions, base.Version) {
+			findings = append(findings, Finding{
+				Resource: typeName,
+				Kind:     findingKindMissingStateUpgrader,
+				Detail:   fmt.Sprintf("schema version changed from %d to %d without a state upgrader from version %d", base.Version, head.Version, base.Version),
+			})
+		}
+	case head.Version == base.Version && typeChanged:
+		findings = append(findings, Finding{
+			Resource: typeName,
+			Kind:     findingKindMissingStateUpgrader,
+			Detail:   fmt.Sprintf("attribute types changed without incrementing schema version %d", base.Version),
+		})
+	}
+
+	return findings
+}
+
+func compareIdentity(typeName string, base, head *IdentitySchema) []Finding {
+	var findings []Finding
+
+	if base == nil {
+		return findings
+	}
+
+	if head == nil {
+		return append(findings, Finding{
+			Resource: typeName,
+			Kind:     findingKindIdentityRemoved,
+			Detail:   "resource identity removed",
+		})
+	}
+
+	for name, baseAttr := range base.Attributes {
+		headAttr, ok := head.Attributes[name]
+
+		switch {
+		case !ok:
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: name,
+				Kind:      findingKindIdentityAttributeChange,
+				Detail:    "identity attribute removed",
+			})
+		case baseAttr.Type != headAttr.Type:
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: name,
+				Kind:      findingKindIdentityAttributeChange,
+				Detail:    fmt.Sprintf("identity attribute type changed from %s to %s", baseAttr.Type, headAttr.Type),
+			})
+		case !baseAttr.RequiredForImport && headAttr.RequiredForImport:
+			findings = append(findings, Finding{
+				Resource:  typeName,
+				Attribute: name,
+				Kind:      findingKindIdentityAttributeChange,
+				Detail:    "identity attribute changed to required for import",
+			})
+		}
+	}
+
+	for name, headAttr := range head.Attributes {
+		if _, ok := base.Attributes[name]; ok {
+			continue
+		}
+
+		detail := "identity attribute added"
+		if headAttr.RequiredForImport {
+			detail = "identity attribute required for import added"
+		}
+
+		findings = append(findings, Finding{
+			Resource:  typeName,
+			Attribute: name,
+			Kind:      findingKindIdentityAttributeChange,
+			Detail:    detail,
+		})
+	}
+
+	if len(findings) > 0 && head.Version == base.Version {
+		findings = append(findings, Finding{
+			Resource: typeName,
+			Kind:     findingKindIdentityAttributeChange,
+			Detail:   fmt.Sprintf("identity schema changed without incrementing identity version %d", base.Version),
+		})
+	}
+
+	return findings
+}
+
+// parentPath returns the path of the block containing the specified attribute path.
+func parentPath(path string) (string, bool) {
+	i := strings.LastIndex(path, ".")
+
+	if i < 0 {
+		return "", false
+	}
+
+	return path[:i], true
+}
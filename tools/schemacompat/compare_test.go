@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	base := &ProviderSchema{
+		Resources: map[string]*ResourceSchema{
+			"aws_example_thing": {
+				Version: 1,
+				Attributes: map[string]*AttributeSchema{
+					"arn":                 {Type: "tftypes.String", Computed: true},
+					"description":         {Type: "tftypes.String", Optional: true},
+					"name":                {Type: "tftypes.String", Required: true},
+					"port":                {Type: "tftypes.String", Optional: true},
+					"settings":            {Type: "block(LIST)", Optional: true},
+					"settings.enabled":    {Type: "tftypes.Bool", Optional: true},
+					"settings.legacy":     {Type: "block(LIST)", Optional: true},
+					"settings.legacy.foo": {Type: "tftypes.String", Optional: true},
+				},
+				Identity: &IdentitySchema{
+					Version: 0,
+					Attributes: map[string]*IdentityAttributeSchema{
+						"name": {Type: "tftypes.String", RequiredForImport: true},
+					},
+				},
+				StateUpgraderVersions: []int64{0},
+			},
+			"aws_example_removed": {
+				Attributes: map[string]*AttributeSchema{},
+			},
+			"aws_example_upgraded": {
+				Version: 0,
+				Attributes: map[string]*AttributeSchema{
+					"name": {Type: "tftypes.String", Required: true},
+				},
+				StateUpgraderVersions: []int64{},
+			},
+		},
+	}
+	head := &ProviderSchema{
+		Resources: map[string]*ResourceSchema{
+			"aws_example_thing": {
+				Version: 1,
+				Attributes: map[string]*AttributeSchema{
+					"arn":              {Type: "tftypes.String", Computed: true},
+					"description":      {Type: "tftypes.String", Required: true},
+					"name":             {Type: "tftypes.String", Required: true, ForceNew: true},
+					"port":             {Type: "tftypes.Number", Optional: true},
+					"settings":         {Type: "block(LIST)", Optional: true},
+					"settings.enabled": {Type: "tftypes.Bool", Optional: true},
+					"settings.mode":    {Type: "tftypes.String", Required: true},
+					"options":          {Type: "block(LIST)", Optional: true},
+					"options.value":    {Type: "tftypes.String", Required: true},
+				},
+				Identity: &IdentitySchema{
+					Version: 0,
+					Attributes: map[string]*IdentityAttributeSchema{
+						"name":   {Type: "tftypes.String", RequiredForImport: true},
+						"region": {Type: "tftypes.String", OptionalForImport: true},
+					},
+				},
+				StateUpgraderVersions: []int64{0},
+			},
+			"aws_example_upgraded": {
+				Version: 1,
+				Attributes: map[string]*AttributeSchema{
+					"name": {Type: "tftypes.String", Required: true},
+				},
+				StateUpgraderVersions: []int64{},
+			},
+		},
+	}
+
+	got := compare(base, head)
+	want := []Finding{
+		{Resource: "aws_example_removed", Kind: findingKindResourceRemoved, Detail: "resource removed"},
+		{Resource: "aws_example_thing", Kind: findingKindIdentityAttributeChange, Detail: "identity schema changed without incrementing identity version 0"},
+		{Resource: "aws_example_thing", Kind: findingKindMissingStateUpgrader, Detail: "attribute types changed without incrementing schema version 1"},
+		{Resource: "aws_example_thing", Attribute: "description", Kind: findingKindOptionalToRequired, Detail: "attribute changed to Required"},
+		{Resource: "aws_example_thing", Attribute: "name", Kind: findingKindForceNewAdded, Detail: "ForceNew added"},
+		{Resource: "aws_example_thing", Attribute: "port", Kind: findingKindTypeChanged, Detail: "type changed from tftypes.String to tftypes.Number"},
+		{Resource: "aws_example_thing", Attribute: "region", Kind: findingKindIdentityAttributeChange, Detail: "identity attribute added"},
+		{Resource: "aws_example_thing", Attribute: "settings.legacy", Kind: findingKindAttributeRemoved, Detail: "attribute removed"},
+		{Resource: "aws_example_thing", Attribute: "settings.mode", Kind: findingKindRequiredAttributeAdded, Detail: "required attribute added"},
+		{Resource: "aws_example_upgraded", Kind: findingKindMissingStateUpgrader, Detail: "schema version changed from 0 to 1 without a state upgrader from version 0"},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestReportWriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Base: "v6.0.0",
+		Head: workingTree,
+		Findings: []Finding{
+			{Resource: "aws_example_thing", Attribute: "name", Kind: findingKindForceNewAdded, Detail: "ForceNew added"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := report.writeMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Join([]string{
+		"# Provider Schema Compatibility: `v6.0.0` to `working tree`",
+		"",
+		"## Breaking Changes",
+		"",
+		"| Resource | Attribute | Kind | Detail |",
+		"| --- | --- | --- | --- |",
+		"| `aws_example_thing` | `name` | force_new_added | ForceNew added |",
+		"",
+		"## Missing State Upgraders",
+		"",
+		"None.",
+		"",
+	}, "\n")
+
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	workingTree = "working tree"
+)
+
+type Options struct {
+	Base           string
+	BaseSchemaFile string
+	Dump           string
+	Head           string
+	HeadSchemaFile string
+	JSONFile       string
+	MarkdownFile   string
+}
+
+func main() {
+	var opts Options
+
+	flag.StringVar(&opts.Base, "base", "", "the git revision to compare against")
+	flag.StringVar(&opts.BaseSchemaFile, "base-schema", "", "a schema file written by -dump to compare against, instead of -base")
+	flag.StringVar(&opts.Head, "head", "", "the git revision to compare (default is the working tree)")
+	flag.StringVar(&opts.HeadSchemaFile, "head-schema", "", "a schema file written by -dump to compare, instead of -head")
+	flag.StringVar(&opts.JSONFile, "json", "", "the file to write the JSON report to (\"-\" for stdout)")
+	flag.StringVar(&opts.MarkdownFile, "markdown", "", "the file to write the Markdown report to (\"-\" for stdout, the default if no report file is specified)")
+	flag.StringVar(&opts.Dump, "dump", "", "write the working tree's provider schema to the file and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s -base <revision> [-head <revision>] [-json <file>] [-markdown <file>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx := context.Background()
+
+	if opts.Dump != "" {
+		ps, err := loadProviderSchema(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := writeProviderSchema(opts.Dump, ps); err != nil {
+			log.Fatalf("writing %s: %s", opts.Dump, err)
+		}
+
+		return
+	}
+
+	if opts.Base == "" && opts.BaseSchemaFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if opts.JSONFile == "" && opts.MarkdownFile == "" {
+		opts.MarkdownFile = "-"
+	}
+
+	base, baseName, err := providerSchema(ctx, opts.Base, opts.BaseSchemaFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	head, headName, err := providerSchema(ctx, opts.Head, opts.HeadSchemaFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report := &Report{
+		Base:     baseName,
+		Head:     headName,
+		Findings: compare(base, head),
+	}
+
+	if opts.JSONFile != "" {
+		if err := writeReport(opts.JSONFile, report.writeJSON); err != nil {
+			log.Fatalf("writing JSON report: %s", err)
+		}
+	}
+
+	if opts.MarkdownFile != "" {
+		if err := writeReport(opts.MarkdownFile, report.writeMarkdown); err != nil {
+			log.Fatalf("writing Markdown report: %s", err)
+		}
+	}
+}
+
+// providerSchema returns the provider schema from the specified schema file, git revision or, if neither is specified, the working tree.
+func providerSchema(ctx context.Context, revision, schemaFile string) (*ProviderSchema, string, error) {
+	switch {
+	case schemaFile != "":
+		ps, err := readProviderSchema(schemaFile)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return ps, schemaFile, nil
+
+	case revision != "":
+		ps, err := revisionProviderSchema(ctx, revision)
+		if err != nil {
+			return nil, "", fmt.Errorf("loading provider schema at %s: %w", revision, err)
+		}
+
+		return ps, revision, nil
+
+	default:
+		ps, err := loadProviderSchema(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return ps, workingTree, nil
+	}
+}
+
+// revisionProviderSchema loads the provider schema at the specified git revision.
+// The revision is checked out into a temporary worktree, this tool's source is copied into it and run with -dump.
+func revisionProviderSchema(ctx context.Context, revision string) (*ProviderSchema, error) {
+	output, err := exec.CommandContext(ctx, "git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return nil, fmt.Errorf("finding repository root: %w", err)
+	}
+	repoRoot := strings.TrimSpace(string(output))
+
+	dir, err := os.MkdirTemp("", "schemacompat")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	worktree := filepath.Join(dir, "worktree")
+
+	if err := run(ctx, repoRoot, "git", "worktree", "add", "--detach", worktree, revision); err != nil {
+		return nil, err
+	}
+	defer run(context.WithoutCancel(ctx), repoRoot, "git", "worktree", "remove", "--force", worktree) //nolint:errcheck // Best effort cleanup.
+
+	toolDir := filepath.Join(repoRoot, "tools", "schemacompat")
+	targetDir := filepath.Join(worktree, "tools", "schemacompat")
+
+	if err := copyToolSource(toolDir, targetDir); err != nil {
+		return nil, fmt.Errorf("copying tool source: %w", err)
+	}
+
+	filename := filepath.Join(dir, "schema.json")
+
+	// Without a go.mod the copied source is built as part of the revision's root module.
+	if err := run(ctx, worktree, "go", "run", "./tools/schemacompat", "-dump", filename); err != nil {
+		return nil, err
+	}
+
+	return readProviderSchema(filename)
+}
+
+// copyToolSource copies the non-test Go source files from one directory to another.
+func copyToolSource(from, to string) error {
+	if err := os.RemoveAll(to); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(to, 0755); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(from, name))
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(to, name), b, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func run(ctx context.Context, dir, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running %s %s: %w", name, strings.Join(args, " "), err)
+	}
+
+	return nil
+}
+
+func writeReport(filename string, write func(io.Writer) error) error {
+	if filename == "-" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return write(f)
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report is the result of comparing the provider schemas of two revisions.
+type Report struct {
+	Base     string    `json:"base"`
+	Head     string    `json:"head"`
+	Findings []Finding `json:"findings"`
+}
+
+func (r *Report) writeJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+func (r *Report) writeMarkdown(w io.Writer) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Provider Schema Compatibility: `%s` to `%s`\n\n", r.Base, r.Head)
+
+	var breaking, upgraders []Finding
+	for _, f := range r.Findings {
+		if f.Kind == findingKindMissingStateUpgrader {
+			upgraders = append(upgraders, f)
+		} else {
+			breaking = append(breaking, f)
+		}
+	}
+
+	sb.WriteString("## Breaking Changes\n\n")
+	writeMarkdownTable(&sb, breaking)
+
+	sb.WriteString("\n## Missing State Upgraders\n\n")
+	writeMarkdownTable(&sb, upgraders)
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+func writeMarkdownTable(sb *strings.Builder, findings []Finding) {
+	if len(findings) == 0 {
+		sb.WriteString("None.\n")
+		return
+	}
+
+	sb.WriteString("| Resource | Attribute | Kind | Detail |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+
+	for _, f := range findings {
+		attribute := ""
+		if f.Attribute != "" {
+			attribute = "`" + f.Attribute + "`"
+		}
+
+		fmt.Fprintf(sb, "| `%s` | %s | %s | %s |\n", f.Resource, attribute, f.Kind, f.Detail)
+	}
+}
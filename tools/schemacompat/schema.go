@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// ProviderSchema is the serialized form of the provider's resource schemas.
+type ProviderSchema struct {
+	Resources map[string]*ResourceSchema `json:"resources"`
+}
+
+// ResourceSchema is the serialized form of a resource's schema.
+type ResourceSchema struct {
+	Version int64 `json:"version"`
+	// Attributes and nested blocks, keyed by path, e.g. "root_block_device.volume_size".
+	Attributes map[string]*AttributeSchema `json:"attributes"`
+	Identity   *IdentitySchema             `json:"identity,omitempty"`
+	// StateUpgraderVersions are the schema versions from which a Plugin SDK v2 resource's state can be upgraded.
+	// Nil for Plugin Framework resources, whose state upgraders cannot be inspected.
+	StateUpgraderVersions []int64 `json:"state_upgrader_versions,omitempty"`
+}
+
+type AttributeSchema struct {
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	Computed bool   `json:"computed,omitempty"`
+	// ForceNew is only known for Plugin SDK v2 resources.
+	ForceNew bool `json:"force_new,omitempty"`
+}
+
+type IdentitySchema struct {
+	Version    int64                               `json:"version"`
+	Attributes map[string]*IdentityAttributeSchema `json:"attributes"`
+}
+
+type IdentityAttributeSchema struct {
+	Type              string `json:"type"`
+	RequiredForImport bool   `json:"required_for_import,omitempty"`
+	OptionalForImport bool   `json:"optional_for_import,omitempty"`
+}
+
+// loadProviderSchema loads the resource schemas of the provider built from this source tree.
+func loadProviderSchema(ctx context.Context) (*ProviderSchema, error) {
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating provider server: %w", err)
+	}
+
+	server := serverFactory()
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+
+	if err := diagnosticsError(schemaResponse.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading provider schema: %w", err)
+	}
+
+	identityResponse, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+
+	if err := diagnosticsError(identityResponse.Diagnostics); err != nil {
+		return nil, fmt.Errorf("reading resource identity schemas: %w", err)
+	}
+
+	ps := &ProviderSchema{
+		Resources: make(map[string]*ResourceSchema, len(schemaResponse.ResourceSchemas)),
+	}
+
+	for typeName, s := range schemaResponse.ResourceSchemas {
+		rs := &ResourceSchema{
+			Version:    s.Version,
+			Attributes: make(map[string]*AttributeSchema),
+		}
+		addBlockAttributes(rs.Attributes, "", s.Block)
+
+		if r, ok := primary.ResourcesMap[typeName]; ok {
+			addForceNew(rs.Attributes, "", r.SchemaMap())
+
+			rs.StateUpgraderVersions = []int64{}
+			for _, v := range r.StateUpgraders {
+				rs.StateUpgraderVersions = append(rs.StateUpgraderVersions, int64(v.Version))
+			}
+			slices.Sort(rs.StateUpgraderVersions)
+		}
+
+		if is, ok := identityResponse.IdentitySchemas[typeName]; ok {
+			rs.Identity = &IdentitySchema{
+				Version:    is.Version,
+				Attributes: make(map[string]*IdentityAttributeSchema, len(is.IdentityAttributes)),
+			}
+
+			for _, attr := range is.IdentityAttributes {
+				rs.Identity.Attributes[attr.Name] = &IdentityAttributeSchema{
+					Type:              attr.Type.String(),
+					RequiredForImport: attr.RequiredForImport,
+					OptionalForImport: attr.OptionalForImport,
+				}
+			}
+		}
+
+		ps.Resources[typeName] = rs
+	}
+
+	return ps, nil
+}
+
+func addBlockAttributes(attributes map[string]*AttributeSchema, prefix string, block *tfprotov5.SchemaBlock) {
+	if block == nil {
+		return
+	}
+
+	for _, attr := range block.Attributes {
+		attributes[prefix+attr.Name] = &AttributeSchema{
+			Type:     attr.Type.String(),
+			Required: attr.Required,
+			Optional: attr.Optional,
+			Computed: attr.Computed,
+		}
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		attributes[prefix+nestedBlock.TypeName] = &AttributeSchema{
+			Type:     fmt.Sprintf("block(%s)", nestedBlock.Nesting),
+			Required: nestedBlock.MinItems > 0,
+			Optional: nestedBlock.MinItems == 0,
+		}
+		addBlockAttributes(attributes, prefix+nestedBlock.TypeName+".", nestedBlock.Block)
+	}
+}
+
+func addForceNew(attributes map[string]*AttributeSchema, prefix string, schemaMap map[string]*schema.Schema) {
+	for name, s := range schemaMap {
+		if attr, ok := attributes[prefix+name]; ok {
+			attr.ForceNew = s.ForceNew
+		}
+
+		if r, ok := s.Elem.(*schema.Resource); ok {
+			addForceNew(attributes, prefix+name+".", r.SchemaMap())
+		}
+	}
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, diag := range diags {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", diag.Summary, diag.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func readProviderSchema(filename string) (*ProviderSchema, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ps ProviderSchema
+
+	if err := json.Unmarshal(b, &ps); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", filename, err)
+	}
+
+	return &ps, nil
+}
+
+func writeProviderSchema(filename string, ps *ProviderSchema) error {
+	b, err := json.MarshalIndent(ps, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, b, 0644)
+}
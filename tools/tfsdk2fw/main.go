@@ -9,14 +9,19 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 )
 
@@ -131,9 +136,12 @@ func (m *migrator) migrate(outputFilename string) error {
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbModels := strings.Builder{}
 	emitter := &emitter{
+		Fields:       make(map[string]string),
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -144,6 +152,31 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	var stateUpgraders []*stateUpgrader
+	if !m.IsDataSource {
+		if emitter.HasTimeouts {
+			emitter.Fields["timeouts"] = "timeouts.Value"
+		}
+
+		for _, v := range m.Resource.StateUpgraders {
+			upgrader, err := emitter.emitStateUpgrader(m.Resource.Schema, v)
+
+			if err != nil {
+				return nil, fmt.Errorf("emitting state upgrader code for version %d: %w", v.Version, err)
+			}
+
+			stateUpgraders = append(stateUpgraders, upgrader)
+		}
+	}
+
+	// The AWS SDK for Go v2 package and the service client are used in the CRUD handler stubs.
+	sdkPackage, serviceClient := m.PackageName, naming.ToCamelCase(m.PackageName)
+	if service, err := data.LookupService(m.PackageName); err == nil {
+		sdkPackage, serviceClient = service.GoV2Package(), service.ProviderNameUpper()
+	} else {
+		m.Generator.Warnf("looking up service package data for %q: %s", m.PackageName, err)
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -155,9 +188,15 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportTags:                   emitter.ImportTags,
+		ImportTimeouts:               emitter.HasTimeouts || emitter.ImportTimeouts,
+		Models:                       sbModels.String(),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		SDKPackage:                   sdkPackage,
+		ServiceClient:                serviceClient,
+		StateUpgraders:               stateUpgraders,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
@@ -190,6 +229,7 @@ type emitter struct {
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	Fields                        map[string]string // Top-level model field types, keyed by attribute name.
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -199,9 +239,12 @@ type emitter struct {
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
+	ImportTimeouts                bool
 	IsDataSource                  bool
+	ModelWriter                   io.Writer // Nested object model structs.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Fields of the model struct currently being emitted.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -262,6 +305,7 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
+	var err error
 	isTopLevelAttribute := len(path) == 0
 
 	// At this point we are emitting code for a schema.Block or Schema.
@@ -295,21 +339,34 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
+		var fieldType string
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fieldType = "types.String"
+		} else if isTopLevelAttribute && isTagsMap(name, property) {
+			e.ImportTags = true
+
+			if name == "tags" {
+				e.HasTopLevelTagsMap = true
+				if property.Optional && !e.IsDataSource {
+					fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+				} else {
+					fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+				}
+			} else {
+				e.HasTopLevelTagsAllMap = true
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
+			fieldType = "tftags.Map"
 		} else {
-			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
+			fieldType, err = e.emitAttributeProperty(append(path, name), property)
+
+			if err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		e.emitField(path, name, fieldType)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -332,12 +389,14 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		fieldType, err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		e.emitField(path, name, fieldType)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -349,12 +408,12 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// The type of the corresponding model struct field is returned.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) (string, error) {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
-	var defaultSpec string
+	var defaultSpec, fieldType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
@@ -364,30 +423,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fieldType = "types.Bool"
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fieldType = "types.Float64"
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fieldType = "types.Int64"
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -399,16 +449,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fieldType = "fwtypes.ARN"
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fieldType = "types.String"
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -424,10 +468,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fieldType = "types.List"
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -437,10 +478,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fieldType = "types.Map"
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -450,10 +488,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fieldType = "types.Set"
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -463,59 +498,59 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			elementType, ok := primitiveType(v.Type)
 
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
+			if !ok {
+				return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.Type.String()))
+			}
 
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
+			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
 
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
+			// Typed aggregates are used so that the model can be expanded and flattened by AutoFlex.
+			if customType, valueType := aggregateOfPrimitiveTypes(typeName, elementType); customType != "" {
+				e.ImportProviderFrameworkTypes = true
+				fieldType = valueType
 
-			case schema.TypeString:
-				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
-
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
 			}
 
-			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+			fprintf(e.SchemaWriter, "ElementType:%sType,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
+			if typeName == "map" {
+				fprintf(e.SchemaWriter, "ElementType:")
+
+				if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+					return "", err
+				}
+
+				fprintf(e.SchemaWriter, ",\n")
+				break
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			e.ImportProviderFrameworkTypes = true
+			modelName := modelName(path)
+			nestedObjectType := naming.ToCamelCase(typeName)
+			fieldType = fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", nestedObjectType, modelName)
+
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", nestedObjectType, modelName)
+			fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+
+			if err := e.emitNestedModel(modelName, func() error {
+				return e.emitComputedOnlyModel(path, v.Schema)
+			}); err != nil {
+				return "", err
+			}
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	if property.Required {
@@ -611,13 +646,15 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return fieldType, nil
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// The type of the corresponding model struct field is returned.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) (string, error) {
 	var planModifiers []string
+	var fieldType string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
 	// At this point we are emitting code for the values of a schema.Block or Schema's Blocks (map[string]schema.Block).
@@ -633,19 +670,24 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			e.ImportProviderFrameworkTypes = true
+			modelName := modelName(path)
+			fieldType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
+			if err := e.emitNestedModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			}); err != nil {
+				return "", err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
 		}
 
 	case schema.TypeSet:
@@ -656,23 +698,28 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			e.ImportProviderFrameworkTypes = true
+			modelName := modelName(path)
+			fieldType = fmt.Sprintf("fwtypes.SetNestedObjectValueOf[%s]", modelName)
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
+			if err := e.emitNestedModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			}); err != nil {
+				return "", err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	// Compatibility hacks.
@@ -728,7 +775,7 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return fieldType, nil
 }
 
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
@@ -854,6 +901,325 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// emitComputedOnlyModel generates the fields of the model struct for a Plugin SDK Computed-only nested block
+// and emits the generated code to the emitter's StructWriter.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitComputedOnlyModel(path []string, schema map[string]*schema.Schema) error {
+	for _, name := range slices.Sorted(maps.Keys(schema)) {
+		fieldType, err := e.computedOnlyFieldType(append(path, name), schema[name])
+
+		if err != nil {
+			return err
+		}
+
+		e.emitField(path, name, fieldType)
+	}
+
+	return nil
+}
+
+// computedOnlyFieldType returns the type of the model struct field for a Plugin SDK Computed-only nested block's property.
+// The types of all values in a nested object must be derivable from the model struct, so typed aggregates are always used.
+func (e *emitter) computedOnlyFieldType(path []string, property *schema.Schema) (string, error) {
+	if fieldType, ok := primitiveType(property.Type); ok {
+		return fieldType, nil
+	}
+
+	var typeName string
+	switch property.Type {
+	case schema.TypeList:
+		typeName = "list"
+	case schema.TypeMap:
+		typeName = "map"
+	case schema.TypeSet:
+		typeName = "set"
+	default:
+		return "", unsupportedTypeError(path, property.Type.String())
+	}
+
+	switch v := property.Elem.(type) {
+	case *schema.Schema:
+		elementType, ok := primitiveType(v.Type)
+
+		if !ok {
+			return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.Type.String()))
+		}
+
+		e.ImportProviderFrameworkTypes = true
+
+		if _, valueType := aggregateOfPrimitiveTypes(typeName, elementType); valueType != "" {
+			return valueType, nil
+		}
+
+		return fmt.Sprintf("fwtypes.ListValueOf[%s]", elementType), nil
+
+	case *schema.Resource:
+		if typeName == "map" {
+			e.warnf("Computed-only nested block %s is a map of objects", strings.Join(path, "/"))
+
+			return "types.Map", nil
+		}
+
+		e.ImportProviderFrameworkTypes = true
+		modelName := modelName(path)
+
+		if err := e.emitNestedModel(modelName, func() error {
+			return e.emitComputedOnlyModel(path, v.Schema)
+		}); err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", naming.ToCamelCase(typeName), modelName), nil
+
+	default:
+		return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
+	}
+}
+
+// emitNestedModel generates the model struct for a nested object and emits the generated code to the emitter's ModelWriter.
+func (e *emitter) emitNestedModel(name string, emitFields func() error) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+	err := emitFields()
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", name, sbStruct.String())
+
+	return nil
+}
+
+// emitField generates a model struct field and emits the generated code to the emitter's StructWriter.
+// The types of top-level fields are recorded.
+func (e *emitter) emitField(path []string, name, fieldType string) {
+	fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+
+	if len(path) == 0 {
+		e.Fields[name] = fieldType
+	}
+}
+
+// emitStateUpgrader generates the Plugin Framework code for a Plugin SDK state upgrader.
+// The prior schema is reproduced from the state upgrader's type, with the current schema used for hints.
+// The state upgrader's Upgrade function is not migrated.
+func (e *emitter) emitStateUpgrader(current map[string]*schema.Schema, upgrader schema.StateUpgrader) (*stateUpgrader, error) {
+	if !upgrader.Type.IsObjectType() {
+		return nil, fmt.Errorf("unsupported type: %s", upgrader.Type.FriendlyName())
+	}
+
+	sbSchema := strings.Builder{}
+	sbStruct := strings.Builder{}
+	attributeTypes := upgrader.Type.AttributeTypes()
+	fields := make(map[string]string)
+
+	fprintf(&sbSchema, "schema.Schema{\n")
+	fprintf(&sbSchema, "Version:%d,\n", upgrader.Version)
+	fprintf(&sbSchema, "Attributes: map[string]schema.Attribute{\n")
+	for _, name := range slices.Sorted(maps.Keys(attributeTypes)) {
+		attributeType := attributeTypes[name]
+
+		// The Plugin SDK adds a "timeouts" block to resources with timeouts.
+		if name == "timeouts" && attributeType.IsObjectType() {
+			continue
+		}
+
+		fprintf(&sbSchema, "%q:", name)
+
+		fieldType, err := e.emitPriorAttribute(&sbSchema, name, attributeType, current[name])
+
+		if err != nil {
+			return nil, err
+		}
+
+		fprintf(&sbSchema, ",\n")
+		fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+		fields[name] = fieldType
+	}
+	fprintf(&sbSchema, "},\n")
+
+	if v, ok := attributeTypes["timeouts"]; ok && v.IsObjectType() {
+		e.ImportTimeouts = true
+
+		fprintf(&sbSchema, "Blocks: map[string]schema.Block{\n")
+		fprintf(&sbSchema, "\"timeouts\": timeouts.Block(ctx, timeouts.Opts{\n")
+		for _, name := range slices.Sorted(maps.Keys(v.AttributeTypes())) {
+			fprintf(&sbSchema, "%s: true,\n", naming.ToCamelCase(name))
+		}
+		fprintf(&sbSchema, "}),\n")
+		fprintf(&sbSchema, "},\n")
+
+		fprintf(&sbStruct, "Timeouts timeouts.Value `tfsdk:\"timeouts\"`\n")
+		fields["timeouts"] = "timeouts.Value"
+	}
+	fprintf(&sbSchema, "}")
+
+	// Copy the values of unchanged attributes to the current model.
+	sbAssignments := strings.Builder{}
+	for _, name := range slices.Sorted(maps.Keys(e.Fields)) {
+		fieldName := naming.ToCamelCase(name)
+
+		switch fieldType, ok := fields[name]; {
+		case !ok:
+			fprintf(&sbAssignments, "// TODO %s: Not in schema version %d.\n", fieldName, upgrader.Version)
+		case fieldType != e.Fields[name]:
+			fprintf(&sbAssignments, "// TODO %[1]s: old.%[1]s, // %[2]s to %[3]s.\n", fieldName, fieldType, e.Fields[name])
+		default:
+			fprintf(&sbAssignments, "%[1]s: old.%[1]s,\n", fieldName)
+		}
+	}
+
+	return &stateUpgrader{
+		Assignments: sbAssignments.String(),
+		Schema:      sbSchema.String(),
+		SDKUpgrader: funcName(upgrader.Upgrade),
+		Struct:      sbStruct.String(),
+		Version:     upgrader.Version,
+	}, nil
+}
+
+// emitPriorAttribute generates the Plugin Framework code for a top-level attribute of a prior schema version
+// and emits the generated code to the specified Writer.
+// The property is the attribute's current Plugin SDK schema, if any.
+// The type of the corresponding model struct field is returned.
+func (e *emitter) emitPriorAttribute(w io.Writer, name string, ty cty.Type, property *schema.Schema) (string, error) {
+	var factory, fieldType string
+
+	switch {
+	case (name == "tags" || name == "tags_all") && ty.Equals(cty.Map(cty.String)):
+		e.ImportTags = true
+
+		if name == "tags" {
+			fprintf(w, "tftags.TagsAttribute()")
+		} else {
+			fprintf(w, "tftags.TagsAttributeComputedOnly()")
+		}
+
+		return "tftags.Map", nil
+
+	case ty.Equals(cty.Bool):
+		factory, fieldType = "schema.BoolAttribute{\n", "types.Bool"
+
+	case ty.Equals(cty.Number):
+		// The Plugin SDK's integer and floating point types are both numbers.
+		switch {
+		case property != nil && property.Type == schema.TypeInt:
+			factory, fieldType = "schema.Int64Attribute{\n", "types.Int64"
+		case property != nil && property.Type == schema.TypeFloat:
+			factory, fieldType = "schema.Float64Attribute{\n", "types.Float64"
+		default:
+			factory, fieldType = "schema.NumberAttribute{\n", "types.Number"
+		}
+
+	case ty.Equals(cty.String):
+		factory, fieldType = "schema.StringAttribute{\n", "types.String"
+
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		elementType, err := e.priorAttrType(name, ty.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case ty.IsListType():
+			factory, fieldType = "schema.ListAttribute{\n", "types.List"
+		case ty.IsMapType():
+			factory, fieldType = "schema.MapAttribute{\n", "types.Map"
+		case ty.IsSetType():
+			factory, fieldType = "schema.SetAttribute{\n", "types.Set"
+		}
+
+		factory += fmt.Sprintf("ElementType:%s,\n", elementType)
+
+	case ty.IsObjectType():
+		attributeTypes, err := e.priorAttrTypes(name, ty)
+
+		if err != nil {
+			return "", err
+		}
+
+		factory, fieldType = fmt.Sprintf("schema.ObjectAttribute{\nAttributeTypes:%s,\n", attributeTypes), "types.Object"
+
+	default:
+		return "", unsupportedTypeError([]string{name}, ty.FriendlyName())
+	}
+
+	fprintf(w, "%s", factory)
+	fprintf(w, "Optional:true,\n")
+	fprintf(w, "}")
+
+	return fieldType, nil
+}
+
+// priorAttrType returns the Plugin Framework attribute type for a value in a prior schema version.
+func (e *emitter) priorAttrType(name string, ty cty.Type) (string, error) {
+	switch {
+	case ty.Equals(cty.Bool):
+		return "types.BoolType", nil
+
+	case ty.Equals(cty.Number):
+		return "types.NumberType", nil
+
+	case ty.Equals(cty.String):
+		return "types.StringType", nil
+
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		elementType, err := e.priorAttrType(name, ty.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case ty.IsListType():
+			return fmt.Sprintf("types.ListType{ElemType:%s}", elementType), nil
+		case ty.IsMapType():
+			return fmt.Sprintf("types.MapType{ElemType:%s}", elementType), nil
+		default:
+			return fmt.Sprintf("types.SetType{ElemType:%s}", elementType), nil
+		}
+
+	case ty.IsObjectType():
+		attributeTypes, err := e.priorAttrTypes(name, ty)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{\nAttrTypes:%s,\n}", attributeTypes), nil
+
+	default:
+		return "", unsupportedTypeError([]string{name}, ty.FriendlyName())
+	}
+}
+
+// priorAttrTypes returns the Plugin Framework attribute types for an object in a prior schema version.
+// Attribute names are sorted prior to code generation to reduce diffs.
+func (e *emitter) priorAttrTypes(name string, ty cty.Type) (string, error) {
+	e.ImportFrameworkAttr = true
+
+	sb := strings.Builder{}
+	attributeTypes := ty.AttributeTypes()
+
+	fprintf(&sb, "map[string]attr.Type{\n")
+	for _, k := range slices.Sorted(maps.Keys(attributeTypes)) {
+		attributeType, err := e.priorAttrType(name, attributeTypes[k])
+
+		if err != nil {
+			return "", err
+		}
+
+		fprintf(&sb, "%q:%s,\n", k, attributeType)
+	}
+	fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -897,6 +1263,88 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isTagsMap returns whether or not the specified top-level property is the resource's tags or tags_all map.
+func isTagsMap(name string, property *schema.Schema) bool {
+	if name != "tags" && name != "tags_all" || property.Type != schema.TypeMap {
+		return false
+	}
+
+	v, ok := property.Elem.(*schema.Schema)
+
+	return ok && v.Type == schema.TypeString
+}
+
+// primitiveType returns the Plugin Framework value type for a Plugin SDK primitive type.
+func primitiveType(v schema.ValueType) (string, bool) {
+	switch v {
+	case schema.TypeBool:
+		return "types.Bool", true
+	case schema.TypeFloat:
+		return "types.Float64", true
+	case schema.TypeInt:
+		return "types.Int64", true
+	case schema.TypeString:
+		return "types.String", true
+	default:
+		return "", false
+	}
+}
+
+// aggregateOfPrimitiveTypes returns the Plugin Framework custom type and value type for a list, map or set of primitives.
+// Empty strings are returned if there is no suitable custom type.
+func aggregateOfPrimitiveTypes(typeName, elementType string) (string, string) {
+	switch typeName {
+	case "list":
+		switch elementType {
+		case "types.Int64":
+			return "fwtypes.ListOfInt64Type", "fwtypes.ListOfInt64"
+		case "types.String":
+			return "fwtypes.ListOfStringType", "fwtypes.ListOfString"
+		}
+
+	case "map":
+		if elementType == "types.String" {
+			return "fwtypes.MapOfStringType", "fwtypes.MapOfString"
+		}
+
+		return fmt.Sprintf("fwtypes.NewMapTypeOf[%s](ctx)", elementType), fmt.Sprintf("fwtypes.MapValueOf[%s]", elementType)
+
+	case "set":
+		if elementType == "types.String" {
+			return "fwtypes.SetOfStringType", "fwtypes.SetOfString"
+		}
+
+		return fmt.Sprintf("fwtypes.NewSetTypeOf[%s](ctx)", elementType), fmt.Sprintf("fwtypes.SetValueOf[%s]", elementType)
+	}
+
+	return "", ""
+}
+
+// modelName returns the name of the model struct for the nested object at the specified path.
+func modelName(path []string) string {
+	name := naming.ToCamelCase(strings.Join(path, "_"))
+
+	return strings.ToLower(name[:1]) + name[1:] + "Model"
+}
+
+// funcName returns the package-qualified name of the specified function.
+// An empty string is returned for function literals, e.g. the wrappers that the provider installs around state upgraders.
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	name := path.Base(runtime.FuncForPC(v.Pointer()).Name())
+
+	if strings.Contains(name, ".func") {
+		return ""
+	}
+
+	return name
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
@@ -915,13 +1363,27 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
+	ImportTimeouts                bool
+	Models                        string // Nested object model structs.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SDKPackage                    string // e.g. ec2
+	ServiceClient                 string // e.g. EC2
+	StateUpgraders                []*stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
 
+type stateUpgrader struct {
+	Assignments string // Assignments of the current model's fields from the prior model.
+	Schema      string
+	SDKUpgrader string // e.g. ec2.instanceMigrateState
+	Struct      string
+	Version     int
+}
+
 //go:embed datasource.gtpl
 var datasourceImpl string
 